@@ -0,0 +1,198 @@
+// Package structure contains the conversions between ncloud SDK types and terraform schema values
+// shared by the resources and data sources of the provider.
+//
+// Every Flatten function accepts nil (or typed nil) input and returns an empty value instead of panicking,
+// and every Expand function skips nil elements of its input list.
+package structure
+
+import (
+	"reflect"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+// ExpandStringInterfaceList converts a schema list of strings to the string pointer list used by requests.
+func ExpandStringInterfaceList(i []interface{}) []*string {
+	vs := make([]*string, 0, len(i))
+	for _, v := range i {
+		switch v := v.(type) {
+		case *string:
+			if v != nil {
+				vs = append(vs, v)
+			}
+		case string:
+			vs = append(vs, ncloud.String(v))
+		}
+	}
+	return vs
+}
+
+// ExpandTagListParams converts a `tag_list` schema value to instance tag parameters.
+func ExpandTagListParams(tl []interface{}) ([]*server.InstanceTagParameter, error) {
+	tagList := make([]*server.InstanceTagParameter, 0, len(tl))
+
+	for _, v := range tl {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tag := new(server.InstanceTagParameter)
+		for key, value := range m {
+			switch key {
+			case "tag_key":
+				tag.TagKey = ncloud.String(value.(string))
+			case "tag_value":
+				tag.TagValue = ncloud.String(value.(string))
+			}
+		}
+		tagList = append(tagList, tag)
+	}
+
+	return tagList, nil
+}
+
+// ExpandLoadBalancerRuleParams converts a `load_balancer_rule_list` schema value to load balancer rule parameters.
+func ExpandLoadBalancerRuleParams(list []interface{}) ([]*loadbalancer.LoadBalancerRuleParameter, error) {
+	lbRuleList := make([]*loadbalancer.LoadBalancerRuleParameter, 0, len(list))
+
+	for _, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		lbRule := new(loadbalancer.LoadBalancerRuleParameter)
+		for key, value := range m {
+			switch key {
+			case "protocol_type_code":
+				lbRule.ProtocolTypeCode = ncloud.String(value.(string))
+			case "load_balancer_port":
+				lbRule.LoadBalancerPort = ncloud.Int32(int32(value.(int)))
+			case "server_port":
+				lbRule.ServerPort = ncloud.Int32(int32(value.(int)))
+			case "l7_health_check_path":
+				lbRule.L7HealthCheckPath = ncloud.String(value.(string))
+			case "certificate_name":
+				lbRule.CertificateName = ncloud.String(value.(string))
+			case "proxy_protocol_use_yn":
+				lbRule.ProxyProtocolUseYn = ncloud.String(value.(string))
+			}
+		}
+		lbRuleList = append(lbRuleList, lbRule)
+	}
+
+	return lbRuleList, nil
+}
+
+// FlattenCommonCode converts any SDK common code (server, loadbalancer, ...) to a code/code_name map.
+func FlattenCommonCode(i interface{}) map[string]interface{} {
+	v, ok := indirect(i)
+	if !ok {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"code":      stringField(v, "Code"),
+		"code_name": stringField(v, "CodeName"),
+	}
+}
+
+// FlattenCommonCodeList wraps a common code into the single element list used by the code/code_name nested attributes.
+func FlattenCommonCodeList(i interface{}) []map[string]interface{} {
+	if _, ok := indirect(i); !ok {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{FlattenCommonCode(i)}
+}
+
+// FlattenRegion converts any SDK region to a map matching the region schema.
+func FlattenRegion(i interface{}) map[string]interface{} {
+	v, ok := indirect(i)
+	if !ok {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"region_no":   stringField(v, "RegionNo"),
+		"region_code": stringField(v, "RegionCode"),
+		"region_name": stringField(v, "RegionName"),
+	}
+}
+
+// FlattenZone converts any SDK zone to a map matching the zone schema.
+func FlattenZone(i interface{}) map[string]interface{} {
+	v, ok := indirect(i)
+	if !ok {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"zone_no":          stringField(v, "ZoneNo"),
+		"zone_code":        stringField(v, "ZoneCode"),
+		"zone_name":        stringField(v, "ZoneName"),
+		"zone_description": stringField(v, "ZoneDescription"),
+		"region_no":        stringField(v, "RegionNo"),
+	}
+}
+
+// FlattenMemberServerImages converts member server images to the list used by the member server image data sources.
+func FlattenMemberServerImages(memberServerImages []*server.MemberServerImage) []map[string]interface{} {
+	var s []map[string]interface{}
+	for _, m := range memberServerImages {
+		if m == nil {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"member_server_image_no":                       ncloud.StringValue(m.MemberServerImageNo),
+			"member_server_image_name":                     ncloud.StringValue(m.MemberServerImageName),
+			"member_server_image_description":              ncloud.StringValue(m.MemberServerImageDescription),
+			"original_server_instance_no":                  ncloud.StringValue(m.OriginalServerInstanceNo),
+			"original_server_product_code":                 ncloud.StringValue(m.OriginalServerProductCode),
+			"original_server_name":                         ncloud.StringValue(m.OriginalServerName),
+			"original_base_block_storage_disk_type":        FlattenCommonCodeList(m.OriginalBaseBlockStorageDiskType),
+			"original_server_image_product_code":           ncloud.StringValue(m.OriginalServerImageProductCode),
+			"original_os_information":                      ncloud.StringValue(m.OriginalOsInformation),
+			"original_server_image_name":                   ncloud.StringValue(m.OriginalServerImageName),
+			"member_server_image_status_name":              ncloud.StringValue(m.MemberServerImageStatusName),
+			"member_server_image_status":                   FlattenCommonCodeList(m.MemberServerImageStatus),
+			"member_server_image_operation":                FlattenCommonCodeList(m.MemberServerImageOperation),
+			"member_server_image_platform_type":            FlattenCommonCodeList(m.MemberServerImagePlatformType),
+			"create_date":                                  ncloud.StringValue(m.CreateDate),
+			"region":                                       FlattenRegion(m.Region),
+			"member_server_image_block_storage_total_rows": int(ncloud.Int32Value(m.MemberServerImageBlockStorageTotalRows)),
+			"member_server_image_block_storage_total_size": int(ncloud.Int64Value(m.MemberServerImageBlockStorageTotalSize)),
+		}
+
+		s = append(s, mapping)
+	}
+
+	return s
+}
+
+// indirect dereferences i down to a struct value. It reports false for nil, typed nil and non struct values.
+func indirect(i interface{}) (reflect.Value, bool) {
+	v := reflect.ValueOf(i)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, v.Kind() == reflect.Struct
+}
+
+// stringField returns the value of the string or *string field name of v, or "" when it is missing or nil.
+func stringField(v reflect.Value, name string) string {
+	f := v.FieldByName(name)
+	switch {
+	case !f.IsValid():
+		return ""
+	case f.Kind() == reflect.String:
+		return f.String()
+	case f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.String:
+		return f.Elem().String()
+	}
+	return ""
+}
@@ -0,0 +1,440 @@
+package structure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+func TestExpandStringInterfaceList(t *testing.T) {
+	cases := map[string]struct {
+		Input    []interface{}
+		Expected []*string
+	}{
+		"strings": {
+			Input:    []interface{}{"1111", "2222", "3333"},
+			Expected: []*string{ncloud.String("1111"), ncloud.String("2222"), ncloud.String("3333")},
+		},
+		"string pointers": {
+			Input:    []interface{}{ncloud.String("1111"), "2222"},
+			Expected: []*string{ncloud.String("1111"), ncloud.String("2222")},
+		},
+		"nil elements": {
+			Input:    []interface{}{nil, "1111", (*string)(nil)},
+			Expected: []*string{ncloud.String("1111")},
+		},
+		"nil list": {
+			Input:    nil,
+			Expected: []*string{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := ExpandStringInterfaceList(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestExpandTagListParams(t *testing.T) {
+	cases := map[string]struct {
+		Input    []interface{}
+		Expected []*server.InstanceTagParameter
+	}{
+		"tags": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"tag_key":   "dev",
+					"tag_value": "web",
+				},
+				map[string]interface{}{
+					"tag_key":   "prod",
+					"tag_value": "auth",
+				},
+			},
+			Expected: []*server.InstanceTagParameter{
+				{TagKey: ncloud.String("dev"), TagValue: ncloud.String("web")},
+				{TagKey: ncloud.String("prod"), TagValue: ncloud.String("auth")},
+			},
+		},
+		"nil elements": {
+			Input: []interface{}{
+				nil,
+				map[string]interface{}{
+					"tag_key":   "dev",
+					"tag_value": "web",
+				},
+			},
+			Expected: []*server.InstanceTagParameter{
+				{TagKey: ncloud.String("dev"), TagValue: ncloud.String("web")},
+			},
+		},
+		"nil list": {
+			Input:    nil,
+			Expected: []*server.InstanceTagParameter{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result, err := ExpandTagListParams(tc.Input)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %s", tn, err)
+		}
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestExpandLoadBalancerRuleParams(t *testing.T) {
+	cases := map[string]struct {
+		Input    []interface{}
+		Expected []*loadbalancer.LoadBalancerRuleParameter
+	}{
+		"rules": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"protocol_type_code":   "HTTP",
+					"load_balancer_port":   80,
+					"server_port":          80,
+					"l7_health_check_path": "/monitor/l7check",
+				},
+				map[string]interface{}{
+					"protocol_type_code":    "HTTPS",
+					"load_balancer_port":    443,
+					"server_port":           443,
+					"l7_health_check_path":  "/monitor/l7check",
+					"certificate_name":      "aaa",
+					"proxy_protocol_use_yn": "N",
+				},
+			},
+			Expected: []*loadbalancer.LoadBalancerRuleParameter{
+				{
+					ProtocolTypeCode:  ncloud.String("HTTP"),
+					LoadBalancerPort:  ncloud.Int32(80),
+					ServerPort:        ncloud.Int32(80),
+					L7HealthCheckPath: ncloud.String("/monitor/l7check"),
+				},
+				{
+					ProtocolTypeCode:   ncloud.String("HTTPS"),
+					LoadBalancerPort:   ncloud.Int32(443),
+					ServerPort:         ncloud.Int32(443),
+					L7HealthCheckPath:  ncloud.String("/monitor/l7check"),
+					CertificateName:    ncloud.String("aaa"),
+					ProxyProtocolUseYn: ncloud.String("N"),
+				},
+			},
+		},
+		"nil elements": {
+			Input:    []interface{}{nil},
+			Expected: []*loadbalancer.LoadBalancerRuleParameter{},
+		},
+		"nil list": {
+			Input:    nil,
+			Expected: []*loadbalancer.LoadBalancerRuleParameter{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result, err := ExpandLoadBalancerRuleParams(tc.Input)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %s", tn, err)
+		}
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenCommonCode(t *testing.T) {
+	var nilServerCode *server.CommonCode
+
+	cases := map[string]struct {
+		Input    interface{}
+		Expected map[string]interface{}
+	}{
+		"server common code": {
+			Input: &server.CommonCode{
+				Code:     ncloud.String("code"),
+				CodeName: ncloud.String("codename"),
+			},
+			Expected: map[string]interface{}{"code": "code", "code_name": "codename"},
+		},
+		"loadbalancer common code": {
+			Input: &loadbalancer.CommonCode{
+				Code:     ncloud.String("HTTP"),
+				CodeName: ncloud.String("http"),
+			},
+			Expected: map[string]interface{}{"code": "HTTP", "code_name": "http"},
+		},
+		"non pointer common code": {
+			Input: server.CommonCode{
+				Code: ncloud.String("code"),
+			},
+			Expected: map[string]interface{}{"code": "code", "code_name": ""},
+		},
+		"nil fields": {
+			Input:    &server.CommonCode{},
+			Expected: map[string]interface{}{"code": "", "code_name": ""},
+		},
+		"typed nil": {
+			Input:    nilServerCode,
+			Expected: map[string]interface{}{},
+		},
+		"nil": {
+			Input:    nil,
+			Expected: map[string]interface{}{},
+		},
+		"not a struct": {
+			Input:    ncloud.String("code"),
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := FlattenCommonCode(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenCommonCodeList(t *testing.T) {
+	var nilServerCode *server.CommonCode
+
+	cases := map[string]struct {
+		Input    interface{}
+		Expected []map[string]interface{}
+	}{
+		"common code": {
+			Input: &server.CommonCode{
+				Code:     ncloud.String("code"),
+				CodeName: ncloud.String("codename"),
+			},
+			Expected: []map[string]interface{}{{"code": "code", "code_name": "codename"}},
+		},
+		"typed nil": {
+			Input:    nilServerCode,
+			Expected: []map[string]interface{}{},
+		},
+		"nil": {
+			Input:    nil,
+			Expected: []map[string]interface{}{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := FlattenCommonCodeList(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenRegion(t *testing.T) {
+	var nilRegion *server.Region
+
+	cases := map[string]struct {
+		Input    interface{}
+		Expected map[string]interface{}
+	}{
+		"region": {
+			Input: &server.Region{
+				RegionNo:   ncloud.String("1"),
+				RegionCode: ncloud.String("KR"),
+				RegionName: ncloud.String("Korea"),
+			},
+			Expected: map[string]interface{}{
+				"region_no":   "1",
+				"region_code": "KR",
+				"region_name": "Korea",
+			},
+		},
+		"loadbalancer region": {
+			Input: &loadbalancer.Region{
+				RegionNo: ncloud.String("1"),
+			},
+			Expected: map[string]interface{}{
+				"region_no":   "1",
+				"region_code": "",
+				"region_name": "",
+			},
+		},
+		"typed nil": {
+			Input:    nilRegion,
+			Expected: map[string]interface{}{},
+		},
+		"nil": {
+			Input:    nil,
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := FlattenRegion(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenZone(t *testing.T) {
+	var nilZone *server.Zone
+
+	cases := map[string]struct {
+		Input    interface{}
+		Expected map[string]interface{}
+	}{
+		"zone": {
+			Input: &server.Zone{
+				ZoneNo:          ncloud.String("3"),
+				ZoneName:        ncloud.String("KR-2"),
+				ZoneCode:        ncloud.String("KR-2"),
+				ZoneDescription: ncloud.String("평촌 zone"),
+				RegionNo:        ncloud.String("1"),
+			},
+			Expected: map[string]interface{}{
+				"zone_no":          "3",
+				"zone_code":        "KR-2",
+				"zone_name":        "KR-2",
+				"zone_description": "평촌 zone",
+				"region_no":        "1",
+			},
+		},
+		"nil fields": {
+			Input: &server.Zone{},
+			Expected: map[string]interface{}{
+				"zone_no":          "",
+				"zone_code":        "",
+				"zone_name":        "",
+				"zone_description": "",
+				"region_no":        "",
+			},
+		},
+		"typed nil": {
+			Input:    nilZone,
+			Expected: map[string]interface{}{},
+		},
+		"nil": {
+			Input:    nil,
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := FlattenZone(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenMemberServerImages(t *testing.T) {
+	cases := map[string]struct {
+		Input    []*server.MemberServerImage
+		Expected []map[string]interface{}
+	}{
+		"images": {
+			Input: []*server.MemberServerImage{
+				{
+					MemberServerImageNo:          ncloud.String("4653"),
+					MemberServerImageName:        ncloud.String("test-1514385790"),
+					MemberServerImageDescription: ncloud.String("server description"),
+					OriginalServerInstanceNo:     ncloud.String("572053"),
+					OriginalServerProductCode:    ncloud.String("SPSVRSTAND000004"),
+					OriginalServerName:           ncloud.String("svr-9bbaf27a2902b5c"),
+					OriginalBaseBlockStorageDiskType: &server.CommonCode{
+						Code:     ncloud.String("NET"),
+						CodeName: ncloud.String("Network Storage"),
+					},
+					OriginalServerImageProductCode: ncloud.String("SPSW0LINUX000043"),
+					OriginalOsInformation:          ncloud.String("CentOS 5.11 (64-bit)"),
+					OriginalServerImageName:        ncloud.String("centos-5.11-64"),
+					MemberServerImageStatusName:    ncloud.String("creating"),
+					MemberServerImageStatus: &server.CommonCode{
+						Code:     ncloud.String("INIT"),
+						CodeName: ncloud.String("NSI INIT state"),
+					},
+					MemberServerImageOperation: &server.CommonCode{
+						Code:     ncloud.String("CREAT"),
+						CodeName: ncloud.String("NSI CREAT OP"),
+					},
+					MemberServerImagePlatformType: &server.CommonCode{
+						Code:     ncloud.String("LNX64"),
+						CodeName: ncloud.String("Linux 64 Bit"),
+					},
+					CreateDate: ncloud.String("2018-01-07T10:17:14+0900"),
+					Region: &server.Region{
+						RegionNo:   ncloud.String("1"),
+						RegionCode: ncloud.String("KR"),
+						RegionName: ncloud.String("Korea"),
+					},
+					MemberServerImageBlockStorageTotalRows: ncloud.Int32(2),
+					MemberServerImageBlockStorageTotalSize: ncloud.Int64(1127428915200),
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"member_server_image_no":                       "4653",
+					"member_server_image_name":                     "test-1514385790",
+					"member_server_image_description":              "server description",
+					"original_server_instance_no":                  "572053",
+					"original_server_product_code":                 "SPSVRSTAND000004",
+					"original_server_name":                         "svr-9bbaf27a2902b5c",
+					"original_base_block_storage_disk_type":        []map[string]interface{}{{"code": "NET", "code_name": "Network Storage"}},
+					"original_server_image_product_code":           "SPSW0LINUX000043",
+					"original_os_information":                      "CentOS 5.11 (64-bit)",
+					"original_server_image_name":                   "centos-5.11-64",
+					"member_server_image_status_name":              "creating",
+					"member_server_image_status":                   []map[string]interface{}{{"code": "INIT", "code_name": "NSI INIT state"}},
+					"member_server_image_operation":                []map[string]interface{}{{"code": "CREAT", "code_name": "NSI CREAT OP"}},
+					"member_server_image_platform_type":            []map[string]interface{}{{"code": "LNX64", "code_name": "Linux 64 Bit"}},
+					"create_date":                                  "2018-01-07T10:17:14+0900",
+					"region":                                       map[string]interface{}{"region_no": "1", "region_code": "KR", "region_name": "Korea"},
+					"member_server_image_block_storage_total_rows": 2,
+					"member_server_image_block_storage_total_size": 1127428915200,
+				},
+			},
+		},
+		"nil elements and fields": {
+			Input: []*server.MemberServerImage{nil, {}},
+			Expected: []map[string]interface{}{
+				{
+					"member_server_image_no":                       "",
+					"member_server_image_name":                     "",
+					"member_server_image_description":              "",
+					"original_server_instance_no":                  "",
+					"original_server_product_code":                 "",
+					"original_server_name":                         "",
+					"original_base_block_storage_disk_type":        []map[string]interface{}{},
+					"original_server_image_product_code":           "",
+					"original_os_information":                      "",
+					"original_server_image_name":                   "",
+					"member_server_image_status_name":              "",
+					"member_server_image_status":                   []map[string]interface{}{},
+					"member_server_image_operation":                []map[string]interface{}{},
+					"member_server_image_platform_type":            []map[string]interface{}{},
+					"create_date":                                  "",
+					"region":                                       map[string]interface{}{},
+					"member_server_image_block_storage_total_rows": 0,
+					"member_server_image_block_storage_total_size": 0,
+				},
+			},
+		},
+		"nil list": {
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for tn, tc := range cases {
+		result := FlattenMemberServerImages(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
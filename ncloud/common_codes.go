@@ -0,0 +1,66 @@
+package ncloud
+
+import (
+	"sort"
+)
+
+// commonCode is an entry of a code table the ncloud APIs accept for a `*_code` argument.
+type commonCode struct {
+	Code     string
+	CodeName string
+}
+
+// staticCommonCodeTables is a snapshot of the code tables used by the arguments of the provider.
+// The classic APIs have no operation to list them, so they are maintained here and served by `data ncloud_common_codes`.
+// A code added to the API is only known once it is added here.
+var staticCommonCodeTables = map[string][]commonCode{
+	"internet_line_type": {
+		{"PUBLC", "Public"},
+		{"GLBL", "Global"},
+	},
+	"fee_system_type": {
+		{"MTRAT", "Time plan"},
+		{"FXSUM", "Flat rate"},
+	},
+	"block_storage_disk_detail_type": {
+		{"HDD", "HDD"},
+		{"SSD", "SSD"},
+	},
+	"load_balancer_algorithm_type": {
+		{"RR", "Round Robin"},
+		{"LC", "Least Connection"},
+		{"SIPHS", "Source IP Hash"},
+	},
+	"load_balancer_network_usage_type": {
+		{"PBLIP", "Public IP"},
+		{"PRVT", "Private IP"},
+	},
+	"load_balancer_protocol_type": {
+		{"HTTP", "HTTP"},
+		{"HTTPS", "HTTPS"},
+		{"TCP", "TCP"},
+		{"SSL", "SSL"},
+	},
+	"nas_volume_allotment_protocol_type": {
+		{"NFS", "NFS"},
+		{"CIFS", "CIFS"},
+	},
+}
+
+// commonCodeValues returns the codes of the code group.
+func commonCodeValues(group string) []string {
+	var values []string
+	for _, c := range staticCommonCodeTables[group] {
+		values = append(values, c.Code)
+	}
+	return values
+}
+
+func commonCodeGroups() []string {
+	var groups []string
+	for group := range staticCommonCodeTables {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
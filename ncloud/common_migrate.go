@@ -0,0 +1,72 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// commonCodeMigrateState returns a MigrateState func moving the given common code attributes
+// from the TypeMap layout of schema version 0 to the single element list layout of version 1.
+func commonCodeMigrateState(names ...string) schema.StateMigrateFunc {
+	return func(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+		switch v {
+		case 0:
+			log.Println("[INFO] Found common code state v0; migrating to v1")
+			return migrateCommonCodeStateV0toV1(is, names)
+		default:
+			return is, fmt.Errorf("unexpected schema version: %d", v)
+		}
+	}
+}
+
+func migrateCommonCodeStateV0toV1(is *terraform.InstanceState, names []string) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	attributes := make(map[string]string, len(is.Attributes))
+	for k, v := range is.Attributes {
+		key, value := migrateCommonCodeAttribute(k, v, names)
+		attributes[key] = value
+	}
+	is.Attributes = attributes
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+
+	return is, nil
+}
+
+// migrateCommonCodeAttribute rewrites `name.%`, `name.code` and `name.code_name` (at any nesting level)
+// to `name.#`, `name.0.code` and `name.0.code_name`.
+func migrateCommonCodeAttribute(k, v string, names []string) (string, string) {
+	parts := strings.Split(k, ".")
+	if len(parts) < 2 || !isCommonCodeName(parts[len(parts)-2], names) {
+		return k, v
+	}
+
+	switch last := parts[len(parts)-1]; last {
+	case "%":
+		parts[len(parts)-1] = "#"
+		if v != "0" {
+			v = "1"
+		}
+	case "code", "code_name":
+		parts = append(parts[:len(parts)-1], "0", last)
+	}
+
+	return strings.Join(parts, "."), v
+}
+
+func isCommonCodeName(name string, names []string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package ncloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCommonCodeMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_1_top_level": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"server_name":                      "tf-test",
+				"server_instance_status.%":         "2",
+				"server_instance_status.code":      "RUN",
+				"server_instance_status.code_name": "Server RUN",
+			},
+			Expected: map[string]string{
+				"server_name":                        "tf-test",
+				"server_instance_status.#":           "1",
+				"server_instance_status.0.code":      "RUN",
+				"server_instance_status.0.code_name": "Server RUN",
+			},
+		},
+		"v0_1_nested": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"load_balancer_rule_list.#":                         "1",
+				"load_balancer_rule_list.0.protocol_type.%":         "2",
+				"load_balancer_rule_list.0.protocol_type.code":      "HTTP",
+				"load_balancer_rule_list.0.protocol_type.code_name": "http",
+			},
+			Expected: map[string]string{
+				"load_balancer_rule_list.#":                           "1",
+				"load_balancer_rule_list.0.protocol_type.#":           "1",
+				"load_balancer_rule_list.0.protocol_type.0.code":      "HTTP",
+				"load_balancer_rule_list.0.protocol_type.0.code_name": "http",
+			},
+		},
+		"v0_1_empty_map": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"platform_type.%": "0",
+			},
+			Expected: map[string]string{
+				"platform_type.#": "0",
+			},
+		},
+		"v0_1_other_maps_untouched": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"zone.%":       "1",
+				"zone.zone_no": "2",
+			},
+			Expected: map[string]string{
+				"zone.%":       "1",
+				"zone.zone_no": "2",
+			},
+		},
+	}
+
+	migrate := commonCodeMigrateState("server_instance_status", "platform_type", "protocol_type")
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "i-1234",
+			Attributes: tc.Attributes,
+		}
+		is, err := migrate(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v\n got: %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestCommonCodeMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	migrate := commonCodeMigrateState("server_instance_status")
+
+	// should handle nil
+	is, err := migrate(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	if _, err := migrate(0, is, nil); err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
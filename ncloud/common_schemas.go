@@ -32,10 +32,12 @@ var zoneSchemaResource = &schema.Resource{
 var commonCodeSchemaResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"code": {
-			Type: schema.TypeString,
+			Type:     schema.TypeString,
+			Computed: true,
 		},
 		"code_name": {
-			Type: schema.TypeString,
+			Type:     schema.TypeString,
+			Computed: true,
 		},
 	},
 }
@@ -56,3 +58,26 @@ var regionSchemaResource = &schema.Resource{
 		},
 	},
 }
+
+// waitForSchemaResource is the `wait_for` block of the data sources returning artifacts created out of band.
+var waitForSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"field": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Attribute of the data source to wait for, e.g. member_server_image_status.0.code",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Value of the attribute to wait for",
+		},
+		"timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "10m",
+			ValidateFunc: validateDuration,
+			Description:  "Maximum time to wait. default: 10m",
+		},
+	},
+}
@@ -1,6 +1,8 @@
 package ncloud
 
 import (
+	"log"
+	"net/http"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -10,6 +12,8 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/monitoring"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http2"
 )
 
 // DefaultWaitForInterval is Interval for checking status in WaitForXXX method
@@ -21,9 +25,24 @@ const DefaultCreateTimeout = 1 * time.Hour
 const DefaultUpdateTimeout = 10 * time.Minute
 const DefaultStopTimeout = 5 * time.Minute
 
+// DefaultBareMetalCreateTimeout is the minimum time to wait for a bare metal server to be provisioned
+const DefaultBareMetalCreateTimeout = 3 * time.Hour
+
+// DefaultReferenceTimeout is how long a newly created object may take to become visible to the APIs referencing it
+const DefaultReferenceTimeout = 2 * time.Minute
+
+// Connection pool size of the transport shared by all service clients.
+// Every service is served by the same API gateway host, so the per host limit equals the total limit.
+const DefaultMaxIdleConns = 100
+
 type Config struct {
-	AccessKey string
-	SecretKey string
+	AccessKey           string
+	SecretKey           string
+	EnableHTTP2         bool
+	PreflightValidation bool
+	ResourceNamePrefix  string
+	ResourceNameSuffix  string
+	Features            Features
 }
 
 type NcloudAPIClient struct {
@@ -33,6 +52,11 @@ type NcloudAPIClient struct {
 	cdn          *cdn.APIClient
 	clouddb      *clouddb.APIClient
 	monitoring   *monitoring.APIClient
+
+	preflightValidation bool
+	resourceNamePrefix  string
+	resourceNameSuffix  string
+	features            Features
 }
 
 func (c *Config) Client() (*NcloudAPIClient, error) {
@@ -40,12 +64,43 @@ func (c *Config) Client() (*NcloudAPIClient, error) {
 		AccessKey: c.AccessKey,
 		SecretKey: c.SecretKey,
 	}
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	withHTTPClient := func(cfg *ncloud.Configuration) *ncloud.Configuration {
+		cfg.HTTPClient = httpClient
+		return cfg
+	}
+
 	return &NcloudAPIClient{
-		server:       server.NewAPIClient(server.NewConfiguration(apiKey)),
-		autoscaling:  autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		loadbalancer: loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		cdn:          cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		clouddb:      clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		monitoring:   monitoring.NewAPIClient(monitoring.NewConfiguration(apiKey)),
+		server:              server.NewAPIClient(withHTTPClient(server.NewConfiguration(apiKey))),
+		autoscaling:         autoscaling.NewAPIClient(withHTTPClient(autoscaling.NewConfiguration(apiKey))),
+		loadbalancer:        loadbalancer.NewAPIClient(withHTTPClient(loadbalancer.NewConfiguration(apiKey))),
+		cdn:                 cdn.NewAPIClient(withHTTPClient(cdn.NewConfiguration(apiKey))),
+		clouddb:             clouddb.NewAPIClient(withHTTPClient(clouddb.NewConfiguration(apiKey))),
+		monitoring:          monitoring.NewAPIClient(withHTTPClient(monitoring.NewConfiguration(apiKey))),
+		preflightValidation: c.PreflightValidation,
+		resourceNamePrefix:  c.ResourceNamePrefix,
+		resourceNameSuffix:  c.ResourceNameSuffix,
+		features:            c.Features,
 	}, nil
 }
+
+// httpClient builds the http.Client shared by all service clients, keeping connections to the API gateway alive
+// between requests instead of doing a new TLS handshake for each call.
+func (c *Config) httpClient() (*http.Client, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.MaxIdleConns = DefaultMaxIdleConns
+	transport.MaxIdleConnsPerHost = DefaultMaxIdleConns
+
+	if c.EnableHTTP2 {
+		log.Printf("[INFO] Enable HTTP/2 for ncloud API requests")
+		if err := http2.ConfigureTransport(transport); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
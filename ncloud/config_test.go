@@ -0,0 +1,44 @@
+package ncloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestConfigHttpClient(t *testing.T) {
+	config := &Config{}
+	httpClient, err := config.httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, but was %T", httpClient.Transport)
+	}
+
+	if transport.DisableKeepAlives {
+		t.Fatal("expected keep-alive to be enabled")
+	}
+
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConns {
+		t.Fatalf("expected MaxIdleConnsPerHost to be %d, but was %d", DefaultMaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+
+	if transport.TLSNextProto != nil {
+		t.Fatal("expected HTTP/2 not to be configured by default")
+	}
+}
+
+func TestConfigHttpClient_http2(t *testing.T) {
+	config := &Config{EnableHTTP2: true}
+	httpClient, err := config.httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	transport := httpClient.Transport.(*http.Transport)
+	if _, ok := transport.TLSNextProto["h2"]; !ok {
+		t.Fatal("expected HTTP/2 to be configured")
+	}
+}
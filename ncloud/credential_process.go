@@ -0,0 +1,47 @@
+package ncloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"runtime"
+)
+
+// CredentialProcessOutput is the JSON document an external credential helper must print to stdout.
+type CredentialProcessOutput struct {
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+// getCredentialProcessOutput executes the configured credential helper through the system shell
+// and parses the access/secret keys it prints.
+func getCredentialProcessOutput(command string) (*CredentialProcessOutput, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[INFO] Executing credential_process")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error executing credential_process: %s %s", err, stderr.String())
+	}
+
+	output := &CredentialProcessOutput{}
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		return nil, fmt.Errorf("error parsing credential_process output: %s", err)
+	}
+
+	if output.AccessKey == "" || output.SecretKey == "" {
+		return nil, fmt.Errorf("credential_process output must contain both `access_key` and `secret_key`")
+	}
+
+	return output, nil
+}
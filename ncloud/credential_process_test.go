@@ -0,0 +1,38 @@
+package ncloud
+
+import (
+	"testing"
+)
+
+func TestGetCredentialProcessOutput(t *testing.T) {
+	output, err := getCredentialProcessOutput(`echo '{"access_key": "accesskey", "secret_key": "secretkey"}'`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if output.AccessKey != "accesskey" {
+		t.Fatalf("expected access_key to be accesskey, but was %s", output.AccessKey)
+	}
+
+	if output.SecretKey != "secretkey" {
+		t.Fatalf("expected secret_key to be secretkey, but was %s", output.SecretKey)
+	}
+}
+
+func TestGetCredentialProcessOutput_missingKey(t *testing.T) {
+	if _, err := getCredentialProcessOutput(`echo '{"access_key": "accesskey"}'`); err == nil {
+		t.Fatal("expected error when secret_key is missing")
+	}
+}
+
+func TestGetCredentialProcessOutput_invalidJSON(t *testing.T) {
+	if _, err := getCredentialProcessOutput(`echo 'accesskey secretkey'`); err == nil {
+		t.Fatal("expected error for invalid JSON output")
+	}
+}
+
+func TestGetCredentialProcessOutput_failedCommand(t *testing.T) {
+	if _, err := getCredentialProcessOutput(`exit 1`); err == nil {
+		t.Fatal("expected error when the command fails")
+	}
+}
@@ -2,10 +2,12 @@ package ncloud
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -17,9 +19,10 @@ func dataSourceNcloudAccessControlGroups() *schema.Resource {
 			"access_control_group_configuration_no_list": {
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				MinItems:    1,
-				Description: "List of ACG configuration numbers you want to get",
+				Description: "List of ACG configuration numbers you want to get. It is set to the configuration numbers of the ACGs found, to use in `access_control_group_configuration_no_list` of `ncloud_server`.",
 			},
 			"is_default_group": {
 				Type:        schema.TypeBool,
@@ -31,6 +34,12 @@ func dataSourceNcloudAccessControlGroups() *schema.Resource {
 				Optional:    true,
 				Description: "Name of the ACG you want to get",
 			},
+			"access_control_group_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+				Description:  "A regex string to apply to the ACG names. All pages are searched, page_no and page_size are ignored.",
+			},
 			"page_no": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -94,17 +103,18 @@ func dataSourceNcloudAccessControlGroupsRead(d *schema.ResourceData, meta interf
 	reqParams := &server.GetAccessControlGroupListRequest{}
 	var paramAccessControlGroupConfigurationNoList []*string
 	if param, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
-		paramAccessControlGroupConfigurationNoList = expandStringInterfaceList(param.([]interface{}))
+		paramAccessControlGroupConfigurationNoList = structure.ExpandStringInterfaceList(param.([]interface{}))
 	}
 	reqParams.AccessControlGroupConfigurationNoList = paramAccessControlGroupConfigurationNoList
 	reqParams.AccessControlGroupName = ncloud.String(d.Get("access_control_group_name").(string))
 	if isDefaultGroup, ok := d.GetOk("is_default_group"); ok {
 		reqParams.IsDefault = ncloud.Bool(isDefaultGroup.(bool))
 	}
-	if pageNo, ok := d.GetOk("page_no"); ok {
+	nameRegex, nameRegexOk := d.GetOk("access_control_group_name_regex")
+	if pageNo, ok := d.GetOk("page_no"); ok && !nameRegexOk {
 		reqParams.PageNo = ncloud.Int32(int32(pageNo.(int)))
 	}
-	if pageSize, ok := d.GetOk("page_size"); ok {
+	if pageSize, ok := d.GetOk("page_size"); ok && !nameRegexOk {
 		reqParams.PageSize = ncloud.Int32(int32(pageSize.(int)))
 	}
 
@@ -115,6 +125,9 @@ func dataSourceNcloudAccessControlGroupsRead(d *schema.ResourceData, meta interf
 	var accessControlGroups []*server.AccessControlGroup
 
 	for _, group := range resp.AccessControlGroupList {
+		if nameRegexOk && !regexp.MustCompile(nameRegex.(string)).MatchString(ncloud.StringValue(group.AccessControlGroupName)) {
+			continue
+		}
 		accessControlGroups = append(accessControlGroups, group)
 	}
 
@@ -144,6 +157,9 @@ func accessControlGroupsAttributes(d *schema.ResourceData, accessControlGroups [
 	}
 
 	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("access_control_group_configuration_no_list", ids); err != nil {
+		return err
+	}
 	if err := d.Set("access_control_groups", flattenAccessControlGroups(accessControlGroups)); err != nil {
 		return err
 	}
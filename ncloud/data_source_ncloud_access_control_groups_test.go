@@ -43,6 +43,26 @@ func TestAccDataSourceNcloudAccessControlGroupsDefault(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudAccessControlGroupsNameRegex(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudAccessControlGroupsNameRegexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_access_control_groups.regex"),
+					resource.TestCheckResourceAttrPair(
+						"data.ncloud_access_control_groups.regex", "access_control_group_configuration_no_list.0",
+						"data.ncloud_access_control_groups.regex", "access_control_groups.0.access_control_group_configuration_no"),
+				),
+			},
+		},
+	})
+}
+
 var testAccDataSourceNcloudAccessControlGroupsConfig = `
 data "ncloud_access_control_groups" "test" {}
 `
@@ -52,3 +72,9 @@ data "ncloud_access_control_groups" "default" {
   "is_default_group" = "true"
 }
 `
+
+var testAccDataSourceNcloudAccessControlGroupsNameRegexConfig = `
+data "ncloud_access_control_groups" "regex" {
+  "access_control_group_name_regex" = "^ncloud-default-acg"
+}
+`
@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -60,7 +61,7 @@ func dataSourceNcloudAccessControlRule() *schema.Resource {
 				Description: "Access control rule configuration no",
 			},
 			"protocol_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Protocol type",
@@ -189,7 +190,7 @@ func accessControlRuleAttributes(d *schema.ResourceData, accessControlRule *serv
 	d.Set("source_access_control_rule_name", accessControlRule.SourceAccessControlRuleName)
 	d.Set("access_control_rule_description", accessControlRule.AccessControlRuleDescription)
 
-	if err := d.Set("protocol_type", flattenCommonCode(accessControlRule.ProtocolType)); err != nil {
+	if err := d.Set("protocol_type", structure.FlattenCommonCodeList(accessControlRule.ProtocolType)); err != nil {
 		return err
 	}
 
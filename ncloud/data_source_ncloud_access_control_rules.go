@@ -16,9 +16,17 @@ func dataSourceNcloudAccessControlRules() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"access_control_group_configuration_no": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Access control group setting number to search",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"access_control_group_name"},
+				Description:   "Access control group setting number to search",
+			},
+			"access_control_group_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_control_group_configuration_no"},
+				Description:   "Name of the access control group to search, instead of its setting number",
 			},
 			"source_access_control_rule_name_regex": {
 				Type:         schema.TypeString,
@@ -37,7 +45,7 @@ func dataSourceNcloudAccessControlRules() *schema.Resource {
 							Computed: true,
 						},
 						"protocol_type": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -68,6 +76,13 @@ func dataSourceNcloudAccessControlRules() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"output_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validateIncludeValues([]string{"json", "csv"}),
+				Description:  "Format of `output_file`. json | csv. default: json",
+			},
 		},
 	}
 }
@@ -78,6 +93,17 @@ func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interfa
 	d.SetId(time.Now().UTC().String())
 
 	id := d.Get("access_control_group_configuration_no").(string)
+	if name, ok := d.GetOk("access_control_group_name"); ok {
+		var err error
+		if id, err = getAccessControlGroupConfigurationNoByName(client, name.(string)); err != nil {
+			return err
+		}
+	}
+	if id == "" {
+		return fmt.Errorf("one of access_control_group_configuration_no and access_control_group_name is required")
+	}
+	d.Set("access_control_group_configuration_no", id)
+
 	reqParams := server.GetAccessControlRuleListRequest{AccessControlGroupConfigurationNo: ncloud.String(id)}
 
 	logCommonRequest("GetAccessControlRuleList", reqParams)
@@ -110,6 +136,21 @@ func dataSourceNcloudAccessControlRulesRead(d *schema.ResourceData, meta interfa
 	return accessControlRulesAttributes(d, filteredAccessControlRuleList)
 }
 
+// getAccessControlGroupConfigurationNoByName resolves an access control group name to its setting number.
+func getAccessControlGroupConfigurationNoByName(client *NcloudAPIClient, name string) (string, error) {
+	resp, err := getAccessControlGroupList(client, &server.GetAccessControlGroupListRequest{AccessControlGroupName: ncloud.String(name)})
+	if err != nil {
+		return "", err
+	}
+	// The name filter of the API matches partial names.
+	for _, group := range resp.AccessControlGroupList {
+		if ncloud.StringValue(group.AccessControlGroupName) == name {
+			return ncloud.StringValue(group.AccessControlGroupConfigurationNo), nil
+		}
+	}
+	return "", fmt.Errorf("no access control group named %q", name)
+}
+
 func accessControlRulesAttributes(d *schema.ResourceData, accessControlRules []*server.AccessControlRule) error {
 	var ids []string
 
@@ -123,8 +164,34 @@ func accessControlRulesAttributes(d *schema.ResourceData, accessControlRules []*
 	}
 
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		writeToFile(output.(string), d.Get("access_control_rules"))
+		if d.Get("output_format").(string) == "csv" {
+			writeCSVToFile(output.(string), accessControlRulesCSVRecords(accessControlRules))
+		} else {
+			writeToFile(output.(string), d.Get("access_control_rules"))
+		}
 	}
 
 	return nil
 }
+
+// accessControlRulesCSVRecords returns the rules as CSV records, one rule per row after the header.
+func accessControlRulesCSVRecords(accessControlRules []*server.AccessControlRule) [][]string {
+	records := [][]string{
+		{"access_control_rule_configuration_no", "protocol_type", "source_ip", "destination_port", "source_access_control_rule_name", "access_control_rule_description"},
+	}
+	for _, rule := range accessControlRules {
+		var protocolType string
+		if rule.ProtocolType != nil {
+			protocolType = ncloud.StringValue(rule.ProtocolType.Code)
+		}
+		records = append(records, []string{
+			ncloud.StringValue(rule.AccessControlRuleConfigurationNo),
+			protocolType,
+			ncloud.StringValue(rule.SourceIp),
+			ncloud.StringValue(rule.DestinationPort),
+			ncloud.StringValue(rule.SourceAccessControlRuleName),
+			ncloud.StringValue(rule.AccessControlRuleDescription),
+		})
+	}
+	return records
+}
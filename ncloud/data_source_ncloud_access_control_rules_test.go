@@ -2,9 +2,12 @@ package ncloud
 
 import (
 	"fmt"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"log"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -40,3 +43,52 @@ data "ncloud_access_control_rules" "test" {
 `, testConfigNo)
 
 }
+
+func TestAccDataSourceNcloudAccessControlRulesByName(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudAccessControlRulesByNameConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_access_control_rules.default"),
+					resource.TestCheckResourceAttrPair(
+						"data.ncloud_access_control_rules.default", "access_control_group_configuration_no",
+						"data.ncloud_access_control_group.default", "access_control_group_configuration_no"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudAccessControlRulesByNameConfig = `
+data "ncloud_access_control_group" "default" {
+	"is_default_group" = "true"
+}
+
+data "ncloud_access_control_rules" "default" {
+	"access_control_group_name" = "${data.ncloud_access_control_group.default.access_control_group_name}"
+}
+`
+
+func TestAccessControlRulesCSVRecords(t *testing.T) {
+	rules := []*server.AccessControlRule{
+		{
+			AccessControlRuleConfigurationNo: ncloud.String("1"),
+			ProtocolType:                     &server.CommonCode{Code: ncloud.String("TCP")},
+			SourceIp:                         ncloud.String("0.0.0.0/0"),
+			DestinationPort:                  ncloud.String("22"),
+			AccessControlRuleDescription:     ncloud.String("ssh, from anywhere"),
+		},
+	}
+
+	r := accessControlRulesCSVRecords(rules)
+
+	expected := []string{"1", "TCP", "0.0.0.0/0", "22", "", "ssh, from anywhere"}
+	if len(r) != 2 || !reflect.DeepEqual(r[1], expected) {
+		t.Fatalf("expected a header and %v, but was %v", expected, r)
+	}
+}
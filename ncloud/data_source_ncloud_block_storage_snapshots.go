@@ -0,0 +1,268 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudBlockStorageSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudBlockStorageSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_snapshot_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+				Description:  "A regex string to apply to the names of the block storage snapshots",
+			},
+			"block_storage_snapshot_instance_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of block storage snapshot instance numbers",
+			},
+			"original_block_storage_instance_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of block storage instance numbers the snapshots were created from",
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCreateDate,
+				Description:  "Keep only the snapshots created after this date, e.g. 2018-07-12T20:32:45+0900",
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCreateDate,
+				Description:  "Keep only the snapshots created before this date, e.g. 2018-07-12T20:32:45+0900",
+			},
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"wait_for": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        waitForSchemaResource,
+				Description: "Wait until block storage snapshots are found and an attribute has a value, e.g. block_storage_snapshots.0.block_storage_snapshot_instance_status.0.code is CREAT",
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep only the most recent created block storage snapshot",
+			},
+			"sort_by_create_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"asc", "desc"}),
+				Description:  "Sort the block storage snapshots by create date. asc (oldest first) | desc (newest first)",
+			},
+
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Block storage snapshot instance numbers of the block storage snapshots",
+			},
+			"block_storage_snapshots": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of block storage snapshots",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_storage_snapshot_instance_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Block storage snapshot instance number",
+						},
+						"block_storage_snapshot_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Block storage snapshot name",
+						},
+						"block_storage_snapshot_volume_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Block storage snapshot volume size",
+						},
+						"original_block_storage_instance_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Original block storage instance number",
+						},
+						"original_block_storage_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Original block storage name",
+						},
+						"block_storage_snapshot_instance_status": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     commonCodeSchemaResource,
+						},
+						"block_storage_snapshot_instance_status_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Block storage snapshot instance status name",
+						},
+						"create_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the block storage snapshot",
+						},
+						"block_storage_snapshot_instance_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Block storage snapshot instance description",
+						},
+						"server_image_product_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server image product code",
+						},
+						"os_information": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "OS information",
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudBlockStorageSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	return readWithWaitFor(d, func() error {
+		return readBlockStorageSnapshots(d, meta)
+	})
+}
+
+func readBlockStorageSnapshots(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+	reqParams := &server.GetBlockStorageSnapshotInstanceListRequest{
+		BlockStorageSnapshotInstanceNoList: structure.ExpandStringInterfaceList(d.Get("block_storage_snapshot_instance_no_list").([]interface{})),
+		OriginalBlockStorageInstanceNoList: structure.ExpandStringInterfaceList(d.Get("original_block_storage_instance_no_list").([]interface{})),
+		RegionNo:                           regionNo,
+	}
+
+	logCommonRequest("GetBlockStorageSnapshotInstanceList", reqParams)
+
+	resp, err := client.server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetBlockStorageSnapshotInstanceList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetBlockStorageSnapshotInstanceList", GetCommonResponse(resp))
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("block_storage_snapshot_name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	var createdAfter, createdBefore time.Time
+	if v, ok := d.GetOk("created_after"); ok {
+		createdAfter, _ = time.Parse(defaultDateFormat, v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		createdBefore, _ = time.Parse(defaultDateFormat, v.(string))
+	}
+	snapshots := filterBlockStorageSnapshots(resp.BlockStorageSnapshotInstanceList, nameRegex, createdAfter, createdBefore)
+
+	if len(snapshots) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	if order, ok := d.GetOk("sort_by_create_date"); ok {
+		sortBlockStorageSnapshotsByCreateDate(snapshots, order.(string))
+	}
+	if d.Get("most_recent").(bool) {
+		snapshots = []*server.BlockStorageSnapshotInstance{mostRecentBlockStorageSnapshot(snapshots)}
+	}
+
+	return blockStorageSnapshotsAttributes(d, snapshots)
+}
+
+// filterBlockStorageSnapshots keeps the snapshots whose name matches nameRegex and that were created between
+// createdAfter and createdBefore. A nil regex or a zero time matches every snapshot.
+func filterBlockStorageSnapshots(snapshots []*server.BlockStorageSnapshotInstance, nameRegex *regexp.Regexp, createdAfter time.Time, createdBefore time.Time) []*server.BlockStorageSnapshotInstance {
+	var filtered []*server.BlockStorageSnapshotInstance
+	for _, snapshot := range snapshots {
+		if nameRegex != nil && !nameRegex.MatchString(ncloud.StringValue(snapshot.BlockStorageSnapshotName)) {
+			continue
+		}
+		createDate, _ := time.Parse(defaultDateFormat, ncloud.StringValue(snapshot.CreateDate))
+		if !createdAfter.IsZero() && !createDate.After(createdAfter) {
+			continue
+		}
+		if !createdBefore.IsZero() && !createDate.Before(createdBefore) {
+			continue
+		}
+		filtered = append(filtered, snapshot)
+	}
+	return filtered
+}
+
+func blockStorageSnapshotsAttributes(d *schema.ResourceData, snapshots []*server.BlockStorageSnapshotInstance) error {
+	var ids []string
+	var s []map[string]interface{}
+
+	for _, snapshot := range snapshots {
+		ids = append(ids, ncloud.StringValue(snapshot.BlockStorageSnapshotInstanceNo))
+		s = append(s, map[string]interface{}{
+			"block_storage_snapshot_instance_no":          ncloud.StringValue(snapshot.BlockStorageSnapshotInstanceNo),
+			"block_storage_snapshot_name":                 ncloud.StringValue(snapshot.BlockStorageSnapshotName),
+			"block_storage_snapshot_volume_size":          int(ncloud.Int64Value(snapshot.BlockStorageSnapshotVolumeSize)),
+			"original_block_storage_instance_no":          ncloud.StringValue(snapshot.OriginalBlockStorageInstanceNo),
+			"original_block_storage_name":                 ncloud.StringValue(snapshot.OriginalBlockStorageName),
+			"block_storage_snapshot_instance_status":      structure.FlattenCommonCodeList(snapshot.BlockStorageSnapshotInstanceStatus),
+			"block_storage_snapshot_instance_status_name": ncloud.StringValue(snapshot.BlockStorageSnapshotInstanceStatusName),
+			"create_date": ncloud.StringValue(snapshot.CreateDate),
+			"block_storage_snapshot_instance_description": ncloud.StringValue(snapshot.BlockStorageSnapshotInstanceDescription),
+			"server_image_product_code":                   ncloud.StringValue(snapshot.ServerImageProductCode),
+			"os_information":                              ncloud.StringValue(snapshot.OsInformation),
+		})
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("block_storage_snapshots", s); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("block_storage_snapshots"))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudBlockStorageSnapshotsMostRecent(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudBlockStorageSnapshotsMostRecentConfig,
+				// ignore check: may be empty created data
+				SkipFunc: func() (bool, error) {
+					return skipNoResultsTest, nil
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_block_storage_snapshots.latest"),
+					resource.TestCheckResourceAttr("data.ncloud_block_storage_snapshots.latest", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudBlockStorageSnapshotsMostRecentConfig = `
+data "ncloud_block_storage_snapshots" "latest" {
+  "created_after" = "2018-01-01T00:00:00+0900"
+  "most_recent" = "true"
+}
+`
+
+func TestFilterBlockStorageSnapshots(t *testing.T) {
+	snapshots := []*server.BlockStorageSnapshotInstance{
+		{BlockStorageSnapshotInstanceNo: ncloud.String("1"), BlockStorageSnapshotName: ncloud.String("db-daily-1"), CreateDate: ncloud.String("2018-07-01T03:00:00+0900")},
+		{BlockStorageSnapshotInstanceNo: ncloud.String("2"), BlockStorageSnapshotName: ncloud.String("db-daily-2"), CreateDate: ncloud.String("2018-07-02T03:00:00+0900")},
+		{BlockStorageSnapshotInstanceNo: ncloud.String("3"), BlockStorageSnapshotName: ncloud.String("web-daily-1"), CreateDate: ncloud.String("2018-07-02T04:00:00+0900")},
+	}
+	date := func(v string) time.Time {
+		d, _ := time.Parse(defaultDateFormat, v)
+		return d
+	}
+
+	cases := []struct {
+		nameRegex     *regexp.Regexp
+		createdAfter  time.Time
+		createdBefore time.Time
+		expected      []string
+	}{
+		{nil, time.Time{}, time.Time{}, []string{"1", "2", "3"}},
+		{regexp.MustCompile("^db-"), time.Time{}, time.Time{}, []string{"1", "2"}},
+		{nil, date("2018-07-01T12:00:00+0900"), time.Time{}, []string{"2", "3"}},
+		{nil, time.Time{}, date("2018-07-02T04:00:00+0900"), []string{"1", "2"}},
+		{regexp.MustCompile("^db-"), date("2018-07-02T03:00:00+0900"), time.Time{}, nil},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, snapshot := range filterBlockStorageSnapshots(snapshots, c.nameRegex, c.createdAfter, c.createdBefore) {
+			ids = append(ids, ncloud.StringValue(snapshot.BlockStorageSnapshotInstanceNo))
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Fatalf("expected %v for regex %v, created after %v and before %v, got %v", c.expected, c.nameRegex, c.createdAfter, c.createdBefore, ids)
+		}
+	}
+}
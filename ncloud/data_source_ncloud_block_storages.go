@@ -0,0 +1,257 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// blockStorageSchemaResource is the element of the `block_storages` list.
+var blockStorageSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"block_storage_instance_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Block storage instance number",
+		},
+		"block_storage_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Block storage name",
+		},
+		"block_storage_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Block storage description",
+		},
+		"server_instance_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server instance number the block storage is attached to",
+		},
+		"server_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server name the block storage is attached to",
+		},
+		"device_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Device name of the block storage on the server",
+		},
+		"block_storage_size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Block storage size in bytes",
+		},
+		"block_storage_size_gb": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Block storage size in GB",
+		},
+		"block_storage_type": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"block_storage_product_code": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Block storage product code",
+		},
+		"block_storage_instance_status": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"disk_type": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"disk_detail_type": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     commonCodeSchemaResource,
+		},
+		"create_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation date of the block storage",
+		},
+	},
+}
+
+func dataSourceNcloudBlockStorages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudBlockStoragesRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server instance number the block storages are attached to",
+			},
+			"block_storage_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"BASIC", "SVRBS"}),
+				Description:  "Block storage type code. Accepted values: BASIC (base storage of a server) | SVRBS (additional storage)",
+			},
+			"block_storage_instance_status_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"INIT", "CREAT", "ATTAC"}),
+				Description:  "Block storage instance status code. Accepted values: INIT | CREAT | ATTAC",
+			},
+			"disk_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"NET", "LOCAL"}),
+				Description:  "Disk type code. Accepted values: NET | LOCAL",
+			},
+			"disk_detail_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues(commonCodeValues("block_storage_disk_detail_type")),
+				Description:  "Disk detail type code. Accepted values: HDD | SSD",
+			},
+			"block_storage_instance_no_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of block storage instance numbers",
+			},
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"zone_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone code. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_no"},
+			},
+			"zone_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone number. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_code"},
+			},
+			"import_resource_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ncloud_block_storage.imported",
+				Description: "Address of the counted `ncloud_block_storage` resource to import the block storages into",
+			},
+
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Block storage instance numbers of the block storages",
+			},
+			"block_storages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        blockStorageSchemaResource,
+				Description: "A list of block storages",
+			},
+			"total_block_storage_size_gb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Sum of the sizes of the block storages in GB",
+			},
+			"import_commands": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "terraform import commands importing each block storage into `import_resource_address`, in the order of `ids`",
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudBlockStoragesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+	zoneNo, err := parseZoneNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+
+	reqParams := &server.GetBlockStorageInstanceListRequest{
+		ServerInstanceNo:               StringPtrOrNil(d.GetOk("server_instance_no")),
+		BlockStorageInstanceStatusCode: StringPtrOrNil(d.GetOk("block_storage_instance_status_code")),
+		DiskTypeCode:                   StringPtrOrNil(d.GetOk("disk_type_code")),
+		DiskDetailTypeCode:             StringPtrOrNil(d.GetOk("disk_detail_type_code")),
+		BlockStorageInstanceNoList:     structure.ExpandStringInterfaceList(d.Get("block_storage_instance_no_list").([]interface{})),
+		RegionNo:                       regionNo,
+		ZoneNo:                         zoneNo,
+	}
+	if blockStorageTypeCode, ok := d.GetOk("block_storage_type_code"); ok {
+		reqParams.BlockStorageTypeCodeList = []*string{ncloud.String(blockStorageTypeCode.(string))}
+	}
+
+	logCommonRequest("GetBlockStorageInstanceList", reqParams)
+
+	resp, err := client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetBlockStorageInstanceList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetBlockStorageInstanceList", GetCommonResponse(resp))
+
+	if len(resp.BlockStorageInstanceList) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	return blockStoragesAttributes(d, resp.BlockStorageInstanceList)
+}
+
+func blockStoragesAttributes(d *schema.ResourceData, blockStorageInstances []*server.BlockStorageInstance) error {
+	var ids []string
+	var totalSizeGb int
+
+	for _, instance := range blockStorageInstances {
+		ids = append(ids, ncloud.StringValue(instance.BlockStorageInstanceNo))
+		totalSizeGb += int(ncloud.Int64Value(instance.BlockStorageSize) / GiB)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("block_storages", flattenBlockStorageInstances(blockStorageInstances)); err != nil {
+		return err
+	}
+	d.Set("total_block_storage_size_gb", totalSizeGb)
+	if err := d.Set("import_commands", importCommands(d.Get("import_resource_address").(string), ids)); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("block_storages"))
+	}
+
+	return nil
+}
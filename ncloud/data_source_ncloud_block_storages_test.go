@@ -0,0 +1,41 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudBlockStoragesBasic(t *testing.T) {
+	testServerName := fmt.Sprintf("tf-ds-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudBlockStoragesConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_block_storages.storages"),
+					resource.TestCheckResourceAttr("data.ncloud_block_storages.storages", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ncloud_block_storages.storages", "ids.0", "ncloud_block_storage.storage", "id"),
+					resource.TestCheckResourceAttr("data.ncloud_block_storages.storages", "block_storages.0.block_storage_size_gb", "10"),
+					resource.TestCheckResourceAttr("data.ncloud_block_storages.storages", "total_block_storage_size_gb", "10"),
+					resource.TestCheckResourceAttrSet("data.ncloud_block_storages.storages", "block_storages.0.device_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudBlockStoragesConfig(testServerName string) string {
+	return testAccBlockStorageConfig(testServerName, testServerName+"-storage") + `
+data "ncloud_block_storages" "storages" {
+	"server_instance_no" = "${ncloud_server.server.id}"
+	"block_storage_type_code" = "SVRBS"
+	"depends_on" = ["ncloud_block_storage.storage"]
+}
+`
+}
@@ -2,12 +2,18 @@ package ncloud
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform/helper/hashcode"
 	"io/ioutil"
 	"log"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 // Generates a hash for the set hash function used by the ID
@@ -32,3 +38,53 @@ func writeToFile(filePath string, data interface{}) {
 		_ = ioutil.WriteFile(filePath, []byte(str), 777)
 	}
 }
+
+// writeCSVToFile writes the records as CSV, the first record being the header.
+func writeCSVToFile(filePath string, records [][]string) {
+	log.Printf("[INFO] WriteCSVToFile FilaPath: %s", filePath)
+	if err := os.Remove(filePath); err != nil {
+		// ignore
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err == nil {
+		_ = ioutil.WriteFile(filePath, buf.Bytes(), 0644)
+	}
+}
+
+// readWithWaitFor runs the read of a data source and, when `wait_for` is set, retries it until it finds results
+// and the `wait_for` field has the expected value.
+func readWithWaitFor(d *schema.ResourceData, read func() error) error {
+	v, ok := d.GetOk("wait_for")
+	if !ok {
+		return read()
+	}
+
+	waitFor := v.([]interface{})[0].(map[string]interface{})
+	field := waitFor["field"].(string)
+	value := waitFor["value"].(string)
+	timeout, err := time.ParseDuration(waitFor["timeout"].(string))
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if err := read(); err != nil {
+			if strings.HasPrefix(err.Error(), "no results") {
+				log.Printf("[DEBUG] Waiting for %s to be %q: %s", field, value, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		actual := d.Get(field)
+		if actual == nil {
+			return resource.NonRetryableError(fmt.Errorf("unknown wait_for field %q", field))
+		}
+		if fmt.Sprint(actual) != value {
+			return resource.RetryableError(fmt.Errorf("%s is %q, waiting for %q", field, fmt.Sprint(actual), value))
+		}
+		return nil
+	})
+}
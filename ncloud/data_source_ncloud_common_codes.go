@@ -0,0 +1,54 @@
+package ncloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudCommonCodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudCommonCodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"code_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIncludeValues(commonCodeGroups()),
+				Description:  "Code group to get, e.g. `internet_line_type`",
+			},
+			"codes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of codes of the code group, from the snapshot built into the provider",
+				Elem:        commonCodeSchemaResource,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudCommonCodesRead(d *schema.ResourceData, meta interface{}) error {
+	group := d.Get("code_group").(string)
+
+	var codes []map[string]interface{}
+	for _, c := range staticCommonCodeTables[group] {
+		codes = append(codes, map[string]interface{}{
+			"code":      c.Code,
+			"code_name": c.CodeName,
+		})
+	}
+
+	d.SetId(dataResourceIdHash(commonCodeValues(group)))
+	if err := d.Set("codes", codes); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write d source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("codes"))
+	}
+
+	return nil
+}
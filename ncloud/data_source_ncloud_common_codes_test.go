@@ -0,0 +1,47 @@
+package ncloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudCommonCodesBasic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudCommonCodesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_common_codes.internet_line_type"),
+					resource.TestCheckResourceAttr("data.ncloud_common_codes.internet_line_type", "codes.#", "2"),
+					resource.TestCheckResourceAttr("data.ncloud_common_codes.internet_line_type", "codes.0.code", "PUBLC"),
+				),
+			},
+		},
+	})
+}
+
+func TestCommonCodeValues(t *testing.T) {
+	if values := commonCodeValues("internet_line_type"); !reflect.DeepEqual(values, []string{"PUBLC", "GLBL"}) {
+		t.Fatalf("unexpected internet_line_type codes: %v", values)
+	}
+	if values := commonCodeValues("unknown"); len(values) != 0 {
+		t.Fatalf("expected no codes for an unknown group, but was %v", values)
+	}
+	for _, group := range commonCodeGroups() {
+		if len(commonCodeValues(group)) == 0 {
+			t.Fatalf("expected codes for group %s", group)
+		}
+	}
+}
+
+var testAccDataSourceNcloudCommonCodesConfig = `
+data "ncloud_common_codes" "internet_line_type" {
+  "code_group" = "internet_line_type"
+}
+`
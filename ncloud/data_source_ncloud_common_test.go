@@ -2,7 +2,10 @@ package ncloud
 
 import (
 	"fmt"
+	"testing"
+
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -21,3 +24,39 @@ func testAccCheckDataSourceID(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestReadWithWaitFor(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"wait_for": {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: waitForSchemaResource},
+			"status":   {Type: schema.TypeString, Computed: true},
+		},
+	}
+	d := r.TestResourceData()
+	d.Set("wait_for", []interface{}{map[string]interface{}{"field": "status", "value": "CREAT", "timeout": "1m"}})
+
+	reads := 0
+	err := readWithWaitFor(d, func() error {
+		reads++
+		switch reads {
+		case 1:
+			return fmt.Errorf("no results. please change search criteria and try again")
+		case 2:
+			d.Set("status", "INIT")
+		default:
+			d.Set("status", "CREAT")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reads != 3 {
+		t.Fatalf("expected 3 reads, but was %d", reads)
+	}
+
+	d.Set("wait_for", []interface{}{map[string]interface{}{"field": "unknown", "value": "CREAT", "timeout": "1m"}})
+	if err := readWithWaitFor(d, func() error { return nil }); err == nil {
+		t.Fatalf("expected an error for an unknown field")
+	}
+}
@@ -0,0 +1,146 @@
+package ncloud
+
+import (
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudEnvironmentSummary() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudEnvironmentSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        zoneSchemaResource,
+				Description: "Zones of the region",
+			},
+			"default_access_control_group_configuration_no": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Default ACG configuration number",
+			},
+			"private_subnets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Private subnets of the region",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private_subnet_instance_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Private subnet instance number",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subnet CIDR",
+						},
+						"private_subnet_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Private subnet description",
+						},
+						"zone_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone number of the private subnet",
+						},
+					},
+				},
+			},
+			"public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        publicIpSchemaResource,
+				Description: "Public IPs of the region",
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudEnvironmentSummaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+
+	zoneReqParams := &server.GetZoneListRequest{RegionNo: regionNo}
+	logCommonRequest("GetZoneList", zoneReqParams)
+	zoneResp, err := client.server.V2Api.GetZoneList(zoneReqParams)
+	if err != nil {
+		logErrorResponse("GetZoneList", err, zoneReqParams)
+		return err
+	}
+	logCommonResponse("GetZoneList", GetCommonResponse(zoneResp))
+	var zones []*Zone
+	for _, zone := range zoneResp.ZoneList {
+		zones = append(zones, GetZone(zone))
+	}
+
+	acgResp, err := getAccessControlGroupList(client, &server.GetAccessControlGroupListRequest{IsDefault: ncloud.Bool(true)})
+	if err != nil {
+		return err
+	}
+
+	subnets, err := getPrivateSubnetInstanceList(client, regionNo)
+	if err != nil {
+		return err
+	}
+
+	publicIpInstances, err := getPublicIpInstanceList(client, &server.GetPublicIpInstanceListRequest{RegionNo: regionNo}, "")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("region_no", regionNo)
+	if err := d.Set("zones", flattenZones(zones)); err != nil {
+		return err
+	}
+	if len(acgResp.AccessControlGroupList) > 0 {
+		d.Set("default_access_control_group_configuration_no", acgResp.AccessControlGroupList[0].AccessControlGroupConfigurationNo)
+	}
+	if err := d.Set("private_subnets", flattenPrivateSubnetInstances(subnets)); err != nil {
+		return err
+	}
+	if err := d.Set("public_ips", flattenPublicIpInstances(publicIpInstances)); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), map[string]interface{}{
+			"region_no": d.Get("region_no"),
+			"zones":     d.Get("zones"),
+			"default_access_control_group_configuration_no": d.Get("default_access_control_group_configuration_no"),
+			"private_subnets": d.Get("private_subnets"),
+			"public_ips":      d.Get("public_ips"),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudEnvironmentSummaryBasic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudEnvironmentSummaryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_environment_summary.env"),
+					resource.TestCheckResourceAttrSet("data.ncloud_environment_summary.env", "zones.#"),
+					resource.TestCheckResourceAttrSet("data.ncloud_environment_summary.env", "default_access_control_group_configuration_no"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudEnvironmentSummaryConfig = `
+data "ncloud_environment_summary" "env" {
+	"region_code" = "KR"
+}
+`
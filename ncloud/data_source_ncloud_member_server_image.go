@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -44,6 +45,13 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 				Description:   "Region number.",
 				ConflictsWith: []string{"region_code"},
 			},
+			"wait_for": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        waitForSchemaResource,
+				Description: "Wait until the member server image is found and an attribute has a value, e.g. member_server_image_status.0.code is CREAT",
+			},
 			"most_recent": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -83,7 +91,7 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 				Description: "Original server name",
 			},
 			"original_base_block_storage_disk_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Original base block storage disk type",
@@ -109,19 +117,19 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 				Description: "Member server image status name",
 			},
 			"member_server_image_status": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Member server image status",
 			},
 			"member_server_image_operation": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Member server image operation",
 			},
 			"member_server_image_platform_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Member server image platform type",
@@ -152,6 +160,12 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 }
 
 func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interface{}) error {
+	return readWithWaitFor(d, func() error {
+		return readMemberServerImage(d, meta)
+	})
+}
+
+func readMemberServerImage(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
 	regionNo, err := parseRegionNoParameter(client, d)
@@ -159,8 +173,8 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 	reqParams := &server.GetMemberServerImageListRequest{
-		MemberServerImageNoList: expandStringInterfaceList(d.Get("member_server_image_no_list").([]interface{})),
-		PlatformTypeCodeList:    expandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
+		MemberServerImageNoList: structure.ExpandStringInterfaceList(d.Get("member_server_image_no_list").([]interface{})),
+		PlatformTypeCodeList:    structure.ExpandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
 		RegionNo:                regionNo,
 	}
 
@@ -193,8 +207,10 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
-	if len(filteredMemberServerImages) > 1 && d.Get("most_recent").(bool) {
-		// Query returned single result.
+	if len(filteredMemberServerImages) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return fmt.Errorf("more than one found results. please change search criteria or set most_recent to true and try again")
+		}
 		memberServerImage = mostRecentMemberServerImage(filteredMemberServerImages)
 	} else {
 		memberServerImage = filteredMemberServerImages[0]
@@ -218,23 +234,23 @@ func memberServerImageAttributes(d *schema.ResourceData, m *server.MemberServerI
 	d.Set("member_server_image_block_storage_total_rows", m.MemberServerImageBlockStorageTotalRows)
 	d.Set("member_server_image_block_storage_total_size", m.MemberServerImageBlockStorageTotalSize)
 
-	if err := d.Set("original_base_block_storage_disk_type", flattenCommonCode(m.OriginalBaseBlockStorageDiskType)); err != nil {
+	if err := d.Set("original_base_block_storage_disk_type", structure.FlattenCommonCodeList(m.OriginalBaseBlockStorageDiskType)); err != nil {
 		return err
 	}
 
-	if err := d.Set("member_server_image_status", flattenCommonCode(m.MemberServerImageStatus)); err != nil {
+	if err := d.Set("member_server_image_status", structure.FlattenCommonCodeList(m.MemberServerImageStatus)); err != nil {
 		return err
 	}
 
-	if err := d.Set("member_server_image_operation", flattenCommonCode(m.MemberServerImageOperation)); err != nil {
+	if err := d.Set("member_server_image_operation", structure.FlattenCommonCodeList(m.MemberServerImageOperation)); err != nil {
 		return err
 	}
 
-	if err := d.Set("member_server_image_platform_type", flattenCommonCode(m.MemberServerImagePlatformType)); err != nil {
+	if err := d.Set("member_server_image_platform_type", structure.FlattenCommonCodeList(m.MemberServerImagePlatformType)); err != nil {
 		return err
 	}
 
-	if err := d.Set("region", flattenRegion(m.Region)); err != nil {
+	if err := d.Set("region", structure.FlattenRegion(m.Region)); err != nil {
 		return err
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -45,6 +46,25 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 				Description:   "Region number. Get available values using the `data ncloud_regions`.",
 				ConflictsWith: []string{"region_code"},
 			},
+			"wait_for": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        waitForSchemaResource,
+				Description: "Wait until member server images are found and an attribute has a value, e.g. member_server_images.0.member_server_image_status.0.code is CREAT",
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep only the most recent created member server image",
+			},
+			"sort_by_create_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"asc", "desc"}),
+				Description:  "Sort the member server images by create date. asc (oldest first) | desc (newest first)",
+			},
 
 			"member_server_images": {
 				Type:        schema.TypeList,
@@ -83,7 +103,7 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 							Description: "Original server name",
 						},
 						"original_base_block_storage_disk_type": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Original base block storage disk type",
@@ -109,19 +129,19 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 							Description: "Member server image status name",
 						},
 						"member_server_image_status": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Member server image status",
 						},
 						"member_server_image_operation": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Member server image operation",
 						},
 						"member_server_image_platform_type": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Member server image platform type",
@@ -160,6 +180,12 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 }
 
 func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interface{}) error {
+	return readWithWaitFor(d, func() error {
+		return readMemberServerImages(d, meta)
+	})
+}
+
+func readMemberServerImages(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
 	regionNo, err := parseRegionNoParameter(client, d)
@@ -167,8 +193,8 @@ func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 	reqParams := server.GetMemberServerImageListRequest{
-		MemberServerImageNoList: expandStringInterfaceList(d.Get("member_server_image_no_list").([]interface{})),
-		PlatformTypeCodeList:    expandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
+		MemberServerImageNoList: structure.ExpandStringInterfaceList(d.Get("member_server_image_no_list").([]interface{})),
+		PlatformTypeCodeList:    structure.ExpandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
 		RegionNo:                regionNo,
 	}
 
@@ -199,6 +225,13 @@ func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	if order, ok := d.GetOk("sort_by_create_date"); ok {
+		sortMemberServerImagesByCreateDate(filteredMemberServerImages, order.(string))
+	}
+	if d.Get("most_recent").(bool) {
+		filteredMemberServerImages = []*server.MemberServerImage{mostRecentMemberServerImage(filteredMemberServerImages)}
+	}
+
 	return memberServerImagesAttributes(d, filteredMemberServerImages)
 }
 
@@ -210,7 +243,7 @@ func memberServerImagesAttributes(d *schema.ResourceData, memberServerImages []*
 	}
 
 	d.SetId(dataResourceIdHash(ids))
-	if err := d.Set("member_server_images", flattenMemberServerImages(memberServerImages)); err != nil {
+	if err := d.Set("member_server_images", structure.FlattenMemberServerImages(memberServerImages)); err != nil {
 		return err
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,7 +17,7 @@ func dataSourceNcloudNasVolume() *schema.Resource {
 			"volume_allotment_protocol_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateIncludeValues([]string{"NFS", "CIFS"}),
+				ValidateFunc: validateIncludeValues(commonCodeValues("nas_volume_allotment_protocol_type")),
 			},
 			"is_event_configuration": {
 				Type:     schema.TypeBool,
@@ -68,7 +69,7 @@ func dataSourceNcloudNasVolume() *schema.Resource {
 				Computed: true,
 			},
 			"nas_volume_instance_status": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -77,7 +78,7 @@ func dataSourceNcloudNasVolume() *schema.Resource {
 				Computed: true,
 			},
 			"volume_allotment_protocol_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -145,7 +146,7 @@ func dataSourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) err
 	}
 	reqParams := &server.GetNasVolumeInstanceListRequest{
 		VolumeAllotmentProtocolTypeCode: ncloud.String(d.Get("volume_allotment_protocol_type_code").(string)),
-		NasVolumeInstanceNoList:         expandStringInterfaceList(d.Get("nas_volume_instance_no_list").([]interface{})),
+		NasVolumeInstanceNoList:         structure.ExpandStringInterfaceList(d.Get("nas_volume_instance_no_list").([]interface{})),
 		RegionNo:                        regionNo,
 		ZoneNo:                          zoneNo,
 	}
@@ -190,11 +191,11 @@ func nasVolumeInstanceAttributes(d *schema.ResourceData, nasVolume *server.NasVo
 	d.Set("is_snapshot_configuration", nasVolume.IsSnapshotConfiguration)
 	d.Set("is_event_configuration", nasVolume.IsEventConfiguration)
 
-	if err := d.Set("nas_volume_instance_status", flattenCommonCode(nasVolume.NasVolumeInstanceStatus)); err != nil {
+	if err := d.Set("nas_volume_instance_status", structure.FlattenCommonCodeList(nasVolume.NasVolumeInstanceStatus)); err != nil {
 		return err
 	}
 
-	if err := d.Set("volume_allotment_protocol_type", flattenCommonCode(nasVolume.VolumeAllotmentProtocolType)); err != nil {
+	if err := d.Set("volume_allotment_protocol_type", structure.FlattenCommonCodeList(nasVolume.VolumeAllotmentProtocolType)); err != nil {
 		return err
 	}
 
@@ -202,11 +203,11 @@ func nasVolumeInstanceAttributes(d *schema.ResourceData, nasVolume *server.NasVo
 		d.Set("nas_volume_instance_custom_ip_list", flattenCustomIPList(nasVolume.NasVolumeInstanceCustomIpList))
 	}
 
-	if err := d.Set("zone", flattenZone(nasVolume.Zone)); err != nil {
+	if err := d.Set("zone", structure.FlattenZone(nasVolume.Zone)); err != nil {
 		return err
 	}
 
-	if err := d.Set("region", flattenRegion(nasVolume.Region)); err != nil {
+	if err := d.Set("region", structure.FlattenRegion(nasVolume.Region)); err != nil {
 		return err
 	}
 
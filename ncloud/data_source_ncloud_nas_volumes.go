@@ -5,6 +5,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,7 +17,7 @@ func dataSourceNcloudNasVolumes() *schema.Resource {
 			"volume_allotment_protocol_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateIncludeValues([]string{"NFS", "CIFS"}),
+				ValidateFunc: validateIncludeValues(commonCodeValues("nas_volume_allotment_protocol_type")),
 			},
 			"is_event_configuration": {
 				Type:     schema.TypeBool,
@@ -72,7 +73,7 @@ func dataSourceNcloudNasVolumes() *schema.Resource {
 							Computed: true,
 						},
 						"nas_volume_instance_status": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -81,7 +82,7 @@ func dataSourceNcloudNasVolumes() *schema.Resource {
 							Computed: true,
 						},
 						"volume_allotment_protocol_type": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -164,7 +165,7 @@ func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) er
 	}
 	reqParams := &server.GetNasVolumeInstanceListRequest{
 		VolumeAllotmentProtocolTypeCode: ncloud.String(d.Get("volume_allotment_protocol_type_code").(string)),
-		NasVolumeInstanceNoList:         expandStringInterfaceList(d.Get("nas_volume_instance_no_list").([]interface{})),
+		NasVolumeInstanceNoList:         structure.ExpandStringInterfaceList(d.Get("nas_volume_instance_no_list").([]interface{})),
 		RegionNo:                        regionNo,
 		ZoneNo:                          zoneNo,
 	}
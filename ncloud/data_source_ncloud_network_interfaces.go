@@ -0,0 +1,234 @@
+package ncloud
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudNetworkInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudNetworkInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"private_subnet_instance_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Private subnet instance number the network interfaces are in",
+			},
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server instance number the network interfaces are attached to",
+			},
+			"network_interface_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IP address of the network interface",
+			},
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"zone_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone code. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_no"},
+			},
+			"zone_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone number. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_code"},
+			},
+
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Network interface numbers of the network interfaces",
+			},
+			"network_interfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of network interfaces",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network interface number",
+						},
+						"network_interface_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network interface name",
+						},
+						"network_interface_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the network interface",
+						},
+						"network_interface_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network interface description",
+						},
+						"server_instance_no": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server instance number the network interface is attached to",
+						},
+						"status_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network interface status code",
+						},
+						"zone": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     zoneSchemaResource,
+						},
+						"region": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     regionSchemaResource,
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+	zoneNo, err := parseZoneNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+
+	// The network interface list does not return the subnet, so the network interfaces are matched with its CIDR.
+	var subnet *net.IPNet
+	if privateSubnetInstanceNo, ok := d.GetOk("private_subnet_instance_no"); ok {
+		subnet, err = getPrivateSubnetCIDR(client, regionNo, privateSubnetInstanceNo.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	reqParams := &server.GetNetworkInterfaceListRequest{
+		RegionNo: regionNo,
+		ZoneNo:   zoneNo,
+	}
+
+	logCommonRequest("GetNetworkInterfaceList", reqParams)
+
+	resp, err := client.server.V2Api.GetNetworkInterfaceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetNetworkInterfaceList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetNetworkInterfaceList", GetCommonResponse(resp))
+
+	nics := filterNetworkInterfaces(resp.NetworkInterfaceList, subnet, d.Get("server_instance_no").(string), d.Get("network_interface_ip").(string))
+
+	if len(nics) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	return networkInterfacesAttributes(d, nics)
+}
+
+func getPrivateSubnetCIDR(client *NcloudAPIClient, regionNo *string, privateSubnetInstanceNo string) (*net.IPNet, error) {
+	subnets, err := getPrivateSubnetInstanceList(client, regionNo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range subnets {
+		if ncloud.StringValue(instance.PrivateSubnetInstanceNo) == privateSubnetInstanceNo {
+			_, subnet, err := net.ParseCIDR(ncloud.StringValue(instance.Subnet))
+			if err != nil {
+				return nil, fmt.Errorf("invalid subnet %q of private subnet instance [%s]: %s", ncloud.StringValue(instance.Subnet), privateSubnetInstanceNo, err)
+			}
+			return subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("private subnet instance [%s] not found", privateSubnetInstanceNo)
+}
+
+// filterNetworkInterfaces keeps the network interfaces whose IP is in subnet, attached to serverInstanceNo and with the IP ip.
+// A nil subnet or an empty filter matches every network interface.
+func filterNetworkInterfaces(nics []*server.NetworkInterface, subnet *net.IPNet, serverInstanceNo string, ip string) []*server.NetworkInterface {
+	var filtered []*server.NetworkInterface
+	for _, nic := range nics {
+		nicIp := ncloud.StringValue(nic.NetworkInterfaceIp)
+		if subnet != nil && !subnet.Contains(net.ParseIP(nicIp)) {
+			continue
+		}
+		if serverInstanceNo != "" && ncloud.StringValue(nic.ServerInstanceNo) != serverInstanceNo {
+			continue
+		}
+		if ip != "" && nicIp != ip {
+			continue
+		}
+		filtered = append(filtered, nic)
+	}
+	return filtered
+}
+
+func networkInterfacesAttributes(d *schema.ResourceData, nics []*server.NetworkInterface) error {
+	var ids []string
+	var s []map[string]interface{}
+
+	for _, nic := range nics {
+		ids = append(ids, ncloud.StringValue(nic.NetworkInterfaceNo))
+		s = append(s, map[string]interface{}{
+			"network_interface_no":          ncloud.StringValue(nic.NetworkInterfaceNo),
+			"network_interface_name":        ncloud.StringValue(nic.NetworkInterfaceName),
+			"network_interface_ip":          ncloud.StringValue(nic.NetworkInterfaceIp),
+			"network_interface_description": ncloud.StringValue(nic.NetworkInterfaceDescription),
+			"server_instance_no":            ncloud.StringValue(nic.ServerInstanceNo),
+			"status_code":                   ncloud.StringValue(nic.StatusCode),
+			"zone":                          structure.FlattenZone(nic.Zone),
+			"region":                        structure.FlattenRegion(nic.Region),
+		})
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("network_interfaces", s); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("network_interfaces"))
+	}
+
+	return nil
+}
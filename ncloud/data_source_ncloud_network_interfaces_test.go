@@ -0,0 +1,71 @@
+package ncloud
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudNetworkInterfacesBasic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudNetworkInterfacesConfig,
+				// ignore check: may be empty created data
+				SkipFunc: func() (bool, error) {
+					return skipNoResultsTest, nil
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_network_interfaces.nics"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudNetworkInterfacesConfig = `
+data "ncloud_network_interfaces" "nics" {
+  "zone_code" = "KR-2"
+}
+`
+
+func TestFilterNetworkInterfaces(t *testing.T) {
+	nics := []*server.NetworkInterface{
+		{NetworkInterfaceNo: ncloud.String("1"), NetworkInterfaceIp: ncloud.String("10.10.1.10"), ServerInstanceNo: ncloud.String("100")},
+		{NetworkInterfaceNo: ncloud.String("2"), NetworkInterfaceIp: ncloud.String("10.10.1.11")},
+		{NetworkInterfaceNo: ncloud.String("3"), NetworkInterfaceIp: ncloud.String("10.10.2.10"), ServerInstanceNo: ncloud.String("100")},
+	}
+	_, subnet, _ := net.ParseCIDR("10.10.1.0/24")
+
+	cases := []struct {
+		subnet           *net.IPNet
+		serverInstanceNo string
+		ip               string
+		expected         []string
+	}{
+		{nil, "", "", []string{"1", "2", "3"}},
+		{subnet, "", "", []string{"1", "2"}},
+		{nil, "100", "", []string{"1", "3"}},
+		{subnet, "100", "", []string{"1"}},
+		{nil, "", "10.10.2.10", []string{"3"}},
+		{subnet, "", "10.10.2.10", nil},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, nic := range filterNetworkInterfaces(nics, c.subnet, c.serverInstanceNo, c.ip) {
+			ids = append(ids, ncloud.StringValue(nic.NetworkInterfaceNo))
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Fatalf("expected %v for subnet %v, server %q and ip %q, got %v", c.expected, c.subnet, c.serverInstanceNo, c.ip, ids)
+		}
+	}
+}
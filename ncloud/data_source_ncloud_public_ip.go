@@ -5,6 +5,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -31,6 +32,12 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 				Optional:    true,
 				Description: "Indicates whether the public IP address is associated or not.",
 			},
+			"server_instance_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"is_associated"},
+				Description:   "Server instance number the public IP is associated with. Implies `is_associated = true`.",
+			},
 			"public_ip_instance_no_list": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -109,7 +116,7 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 				Description: "Creation date of the public ip",
 			},
 			"internet_line_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Internet line type",
@@ -120,19 +127,19 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 				Description: "Public IP instance status name",
 			},
 			"public_ip_instance_status": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Public IP instance status",
 			},
 			"public_ip_instance_operation": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Public IP instance operation",
 			},
 			"public_ip_kind_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Public IP kind type",
@@ -168,33 +175,21 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
-	regionNo, err := parseRegionNoParameter(client, d)
-	if err != nil {
-		return err
-	}
-	zoneNo, err := parseZoneNoParameter(client, d)
+	reqParams, err := buildGetPublicIpInstanceListReqParams(client, d)
 	if err != nil {
 		return err
 	}
-	reqParams := new(server.GetPublicIpInstanceListRequest)
-	reqParams.InternetLineTypeCode = ncloud.String(d.Get("internet_line_type_code").(string))
-	reqParams.IsAssociated = ncloud.Bool(d.Get("is_associated").(bool))
-	reqParams.PublicIpInstanceNoList = expandStringInterfaceList(d.Get("public_ip_instance_no_list").([]interface{}))
-	reqParams.PublicIpList = expandStringInterfaceList(d.Get("public_ip_list").([]interface{}))
+	reqParams.PublicIpInstanceNoList = structure.ExpandStringInterfaceList(d.Get("public_ip_instance_no_list").([]interface{}))
+	reqParams.PublicIpList = structure.ExpandStringInterfaceList(d.Get("public_ip_list").([]interface{}))
 	reqParams.SearchFilterName = ncloud.String(d.Get("search_filter_name").(string))
 	reqParams.SearchFilterValue = ncloud.String(d.Get("search_filter_value").(string))
-	reqParams.RegionNo = regionNo
-	reqParams.ZoneNo = zoneNo
 	reqParams.SortedBy = ncloud.String(d.Get("sorted_by").(string))
 	reqParams.SortingOrder = ncloud.String(d.Get("sorting_order").(string))
-	// log.Printf("[DEBUG] GetPublicIpInstanceList reqParams: %#v", reqParams)
-	resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
 
+	publicIpInstanceList, err := getPublicIpInstanceList(client, reqParams, d.Get("server_instance_no").(string))
 	if err != nil {
-		logErrorResponse("Get Public IP Instance", err, reqParams)
 		return err
 	}
-	publicIpInstanceList := resp.PublicIpInstanceList
 	var publicIpInstance *server.PublicIpInstance
 
 	if len(publicIpInstanceList) < 1 {
@@ -211,6 +206,60 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 	return publicIPAttributes(d, publicIpInstance)
 }
 
+// buildGetPublicIpInstanceListReqParams builds the filters shared by the ncloud_public_ip and ncloud_public_ips data sources.
+func buildGetPublicIpInstanceListReqParams(client *NcloudAPIClient, d *schema.ResourceData) (*server.GetPublicIpInstanceListRequest, error) {
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return nil, err
+	}
+	zoneNo, err := parseZoneNoParameter(client, d)
+	if err != nil {
+		return nil, err
+	}
+
+	reqParams := &server.GetPublicIpInstanceListRequest{
+		InternetLineTypeCode: ncloud.String(d.Get("internet_line_type_code").(string)),
+		RegionNo:             regionNo,
+		ZoneNo:               zoneNo,
+	}
+	// Without is_associated, the public IPs are listed whether they are associated or not.
+	if isAssociated, ok := d.GetOkExists("is_associated"); ok {
+		reqParams.IsAssociated = ncloud.Bool(isAssociated.(bool))
+	}
+	if d.Get("server_instance_no").(string) != "" {
+		reqParams.IsAssociated = ncloud.Bool(true)
+	}
+
+	return reqParams, nil
+}
+
+// getPublicIpInstanceList gets the public IP instances matching reqParams.
+// The API cannot filter by associated server, so a non empty serverInstanceNo is applied to the results.
+func getPublicIpInstanceList(client *NcloudAPIClient, reqParams *server.GetPublicIpInstanceListRequest, serverInstanceNo string) ([]*server.PublicIpInstance, error) {
+	logCommonRequest("GetPublicIpInstanceList", reqParams)
+	resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetPublicIpInstanceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetPublicIpInstanceList", GetCommonResponse(resp))
+
+	if serverInstanceNo == "" {
+		return resp.PublicIpInstanceList, nil
+	}
+	return filterPublicIpInstancesByServerInstanceNo(resp.PublicIpInstanceList, serverInstanceNo), nil
+}
+
+func filterPublicIpInstancesByServerInstanceNo(publicIpInstances []*server.PublicIpInstance, serverInstanceNo string) []*server.PublicIpInstance {
+	var filtered []*server.PublicIpInstance
+	for _, instance := range publicIpInstances {
+		if serverInstance := instance.ServerInstanceAssociatedWithPublicIp; serverInstance != nil && ncloud.StringValue(serverInstance.ServerInstanceNo) == serverInstanceNo {
+			filtered = append(filtered, instance)
+		}
+	}
+	return filtered
+}
+
 func publicIPAttributes(d *schema.ResourceData, instance *server.PublicIpInstance) error {
 
 	d.SetId(ncloud.StringValue(instance.PublicIpInstanceNo))
@@ -220,16 +269,16 @@ func publicIPAttributes(d *schema.ResourceData, instance *server.PublicIpInstanc
 	d.Set("create_date", instance.CreateDate)
 	d.Set("public_ip_instance_status_name", instance.PublicIpInstanceStatusName)
 
-	if err := d.Set("internet_line_type", flattenCommonCode(instance.InternetLineType)); err != nil {
+	if err := d.Set("internet_line_type", structure.FlattenCommonCodeList(instance.InternetLineType)); err != nil {
 		return err
 	}
-	if err := d.Set("public_ip_instance_status", flattenCommonCode(instance.PublicIpInstanceStatus)); err != nil {
+	if err := d.Set("public_ip_instance_status", structure.FlattenCommonCodeList(instance.PublicIpInstanceStatus)); err != nil {
 		return err
 	}
-	if err := d.Set("public_ip_instance_operation", flattenCommonCode(instance.PublicIpInstanceOperation)); err != nil {
+	if err := d.Set("public_ip_instance_operation", structure.FlattenCommonCodeList(instance.PublicIpInstanceOperation)); err != nil {
 		return err
 	}
-	if err := d.Set("public_ip_kind_type", flattenCommonCode(instance.PublicIpKindType)); err != nil {
+	if err := d.Set("public_ip_kind_type", structure.FlattenCommonCodeList(instance.PublicIpKindType)); err != nil {
 		return err
 	}
 
@@ -1,6 +1,9 @@
 package ncloud
 
 import (
+	"fmt"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"testing"
 )
@@ -119,6 +122,50 @@ func TestAccDataSourceNcloudPublicIpSorting(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudPublicIpServerInstanceNo(t *testing.T) {
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudPublicIpServerInstanceNoConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ncloud_public_ip.test", "public_ip",
+						"ncloud_public_ip.public_ip", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterPublicIpInstancesByServerInstanceNo(t *testing.T) {
+	publicIpInstances := []*server.PublicIpInstance{
+		{
+			PublicIpInstanceNo:                   ncloud.String("100"),
+			ServerInstanceAssociatedWithPublicIp: &server.ServerInstance{ServerInstanceNo: ncloud.String("200")},
+		},
+		{
+			PublicIpInstanceNo:                   ncloud.String("101"),
+			ServerInstanceAssociatedWithPublicIp: &server.ServerInstance{ServerInstanceNo: ncloud.String("201")},
+		},
+		{
+			PublicIpInstanceNo: ncloud.String("102"),
+		},
+	}
+
+	result := filterPublicIpInstancesByServerInstanceNo(publicIpInstances, "201")
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+	if ncloud.StringValue(result[0].PublicIpInstanceNo) != "101" {
+		t.Fatalf("expected public ip instance '101', but was %s", ncloud.StringValue(result[0].PublicIpInstanceNo))
+	}
+}
+
 var testAccDataSourceNcloudPublicIpConfig = `
 data "ncloud_public_ip" "test" {}
 `
@@ -151,3 +198,26 @@ data "ncloud_public_ip" "test" {
   "most_recent" = "true"
 }
 `
+
+func testAccDataSourceNcloudPublicIpServerInstanceNoConfig(testServerName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%s-key"
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+}
+
+resource "ncloud_public_ip" "public_ip" {
+	"server_instance_no" = "${ncloud_server.server.id}"
+}
+
+data "ncloud_public_ip" "test" {
+	"server_instance_no" = "${ncloud_public_ip.public_ip.server_instance_no}"
+}
+`, testServerName, testServerName)
+}
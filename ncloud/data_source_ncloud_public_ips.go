@@ -0,0 +1,151 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// publicIpSchemaResource is the element of the `public_ips` lists.
+var publicIpSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"public_ip_instance_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP instance number",
+		},
+		"public_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP",
+		},
+		"public_ip_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP description",
+		},
+		"public_ip_instance_status_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP instance status name",
+		},
+		"server_instance_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Associated server instance number",
+		},
+		"server_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Associated server name",
+		},
+		"create_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation date of the public ip",
+		},
+	},
+}
+
+func dataSourceNcloudPublicIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudPublicIpsRead,
+
+		Schema: map[string]*schema.Schema{
+			"internet_line_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateInternetLineTypeCode,
+				Description:  "Internet line type code. `PUBLC` (Public), `GLBL` (Global)",
+			},
+			"is_associated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Indicates whether the public IP address is associated or not. Set `false` to get the public IPs not associated with any server.",
+			},
+			"server_instance_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"is_associated"},
+				Description:   "Server instance number the public IPs are associated with. Implies `is_associated = true`.",
+			},
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"zone_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone code. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_no"},
+			},
+			"zone_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone number. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_code"},
+			},
+
+			"public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of public IPs",
+				Elem:        publicIpSchemaResource,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudPublicIpsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	reqParams, err := buildGetPublicIpInstanceListReqParams(client, d)
+	if err != nil {
+		return err
+	}
+
+	publicIpInstances, err := getPublicIpInstanceList(client, reqParams, d.Get("server_instance_no").(string))
+	if err != nil {
+		return err
+	}
+
+	if len(publicIpInstances) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	return publicIpsAttributes(d, publicIpInstances)
+}
+
+func publicIpsAttributes(d *schema.ResourceData, publicIpInstances []*server.PublicIpInstance) error {
+	var ids []string
+
+	for _, instance := range publicIpInstances {
+		ids = append(ids, ncloud.StringValue(instance.PublicIpInstanceNo))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("public_ips", flattenPublicIpInstances(publicIpInstances)); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write d source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("public_ips"))
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"testing"
+)
+
+func TestAccDataSourceNcloudPublicIpsNotAssociated(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudPublicIpsNotAssociatedConfig,
+				// ignore check: may be empty created data
+				SkipFunc: func() (bool, error) {
+					return skipNoResultsTest, nil
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_public_ips.free"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNcloudPublicIpsNotAssociatedConfig = `
+data "ncloud_public_ips" "free" {
+  "is_associated" = "false"
+}
+`
+
+func TestBuildGetPublicIpInstanceListReqParamsIsAssociated(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected *bool
+	}{
+		{map[string]interface{}{}, nil},
+		{map[string]interface{}{"is_associated": false}, ncloud.Bool(false)},
+		{map[string]interface{}{"is_associated": true}, ncloud.Bool(true)},
+		{map[string]interface{}{"server_instance_no": "100"}, ncloud.Bool(true)},
+	}
+
+	for _, c := range cases {
+		c.raw["region_no"] = "1"
+		d := schema.TestResourceDataRaw(t, dataSourceNcloudPublicIps().Schema, c.raw)
+		reqParams, err := buildGetPublicIpInstanceListReqParams(nil, d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (reqParams.IsAssociated == nil) != (c.expected == nil) || (c.expected != nil && *reqParams.IsAssociated != *c.expected) {
+			t.Fatalf("expected IsAssociated %v for %v, but was %v", ncloud.BoolValue(c.expected), c.raw, ncloud.BoolValue(reqParams.IsAssociated))
+		}
+	}
+}
+
+func TestPublicIpServerInstanceNoConflictsWithIsAssociated(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"server_instance_no": "100",
+		"is_associated":      false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, r := range map[string]*schema.Resource{"ncloud_public_ip": dataSourceNcloudPublicIp(), "ncloud_public_ips": dataSourceNcloudPublicIps()} {
+		if _, errs := r.Validate(terraform.NewResourceConfig(raw)); len(errs) == 0 {
+			t.Fatalf("expected an error for %s with both server_instance_no and is_associated", name)
+		}
+	}
+}
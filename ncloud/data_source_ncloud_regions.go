@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -66,7 +67,7 @@ func regionsAttributes(d *schema.ResourceData, regions []*Region) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, region := range regions {
-		mapping := flattenRegion(region)
+		mapping := structure.FlattenRegion(region)
 		ids = append(ids, *region.RegionNo)
 		s = append(s, mapping)
 	}
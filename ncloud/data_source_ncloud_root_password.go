@@ -36,22 +36,33 @@ func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{})
 	client := meta.(*NcloudAPIClient)
 
 	serverInstanceNo := d.Get("server_instance_no").(string)
-	privateKey := d.Get("private_key").(string)
+	rootPassword, err := getRootPassword(client, serverInstanceNo, d.Get("private_key").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serverInstanceNo)
+	d.Set("root_password", rootPassword)
+
+	return nil
+}
+
+// getRootPassword decrypts the root password of the server with the private key of its login key.
+func getRootPassword(client *NcloudAPIClient, serverInstanceNo string, privateKey string) (*string, error) {
 	reqParams := &server.GetRootPasswordRequest{
 		ServerInstanceNo: ncloud.String(serverInstanceNo),
 		PrivateKey:       ncloud.String(privateKey),
 	}
 
-	logCommonRequest("GetRootPassword", reqParams)
+	// The private key must not be written to the logs.
+	logParams := &server.GetRootPasswordRequest{ServerInstanceNo: reqParams.ServerInstanceNo}
+	logCommonRequest("GetRootPassword", logParams)
 	resp, err := client.server.V2Api.GetRootPassword(reqParams)
 	if err != nil {
-		logErrorResponse("GetRootPassword", err, reqParams)
-		return err
+		logErrorResponse("GetRootPassword", err, logParams)
+		return nil, err
 	}
 	logCommonResponse("GetRootPassword", GetCommonResponse(resp))
 
-	d.SetId(serverInstanceNo)
-	d.Set("root_password", resp.RootPassword)
-
-	return nil
+	return resp.RootPassword, nil
 }
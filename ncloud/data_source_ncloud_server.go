@@ -0,0 +1,263 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudServer() *schema.Resource {
+	s := serverInstanceDataSourceSchema()
+	s["server_instance_no"].Optional = true
+	s["server_instance_no"].ConflictsWith = []string{"server_name"}
+	s["server_instance_no"].Description = "Server instance number of the server to get"
+	s["server_name"].Optional = true
+	s["server_name"].ConflictsWith = []string{"server_instance_no"}
+	s["server_name"].Description = "Exact name of the server to get"
+
+	return &schema.Resource{
+		Read:   dataSourceNcloudServerRead,
+		Schema: s,
+	}
+}
+
+func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	serverName := d.Get("server_name").(string)
+	if serverInstanceNo == "" && serverName == "" {
+		return fmt.Errorf("one of server_instance_no or server_name must be set")
+	}
+
+	reqParams := &server.GetServerInstanceListRequest{}
+	if serverInstanceNo != "" {
+		reqParams.ServerInstanceNoList = []*string{ncloud.String(serverInstanceNo)}
+	} else {
+		reqParams.SearchFilterName = ncloud.String("serverName")
+		reqParams.SearchFilterValue = ncloud.String(serverName)
+	}
+
+	serverInstances, err := getServerInstanceList(client, reqParams)
+	if err != nil {
+		return err
+	}
+
+	var filteredServerInstances []*server.ServerInstance
+	for _, instance := range serverInstances {
+		// The server name search filter matches partial names.
+		if serverName == "" || ncloud.StringValue(instance.ServerName) == serverName {
+			filteredServerInstances = append(filteredServerInstances, instance)
+		}
+	}
+
+	if len(filteredServerInstances) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+	if len(filteredServerInstances) > 1 {
+		return fmt.Errorf("more than one found results. please change search criteria and try again")
+	}
+
+	instance := filteredServerInstances[0]
+	d.SetId(ncloud.StringValue(instance.ServerInstanceNo))
+	for k, v := range flattenServerInstance(instance) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getServerInstanceList(client *NcloudAPIClient, reqParams *server.GetServerInstanceListRequest) ([]*server.ServerInstance, error) {
+	logCommonRequest("GetServerInstanceList", reqParams)
+
+	resp, err := client.server.V2Api.GetServerInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetServerInstanceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetServerInstanceList", GetCommonResponse(resp))
+
+	return resp.ServerInstanceList, nil
+}
+
+// serverInstanceDataSourceSchema returns the attributes of a server instance exposed by the server data sources.
+func serverInstanceDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server_instance_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server instance number",
+		},
+		"server_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server name",
+		},
+		"server_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server description",
+		},
+		"metadata": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Metadata of the server, as set by the `metadata` argument of `ncloud_server`",
+		},
+		"server_image_product_code": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server image product code",
+		},
+		"server_product_code": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server product code",
+		},
+		"server_image_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server image name",
+		},
+		"login_key_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Login key name",
+		},
+		"cpu_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of CPUs",
+		},
+		"memory_size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory size in bytes",
+		},
+		"base_block_storage_size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Base block storage size in bytes",
+		},
+		"is_fee_charging_monitoring": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether detailed monitoring is charged",
+		},
+		"is_protect_server_termination": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether termination protection is enabled",
+		},
+		"public_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP",
+		},
+		"private_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Private IP",
+		},
+		"port_forwarding_public_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Port forwarding public IP",
+		},
+		"port_forwarding_external_port": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Port forwarding external port",
+		},
+		"port_forwarding_internal_port": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Port forwarding internal port",
+		},
+		"server_instance_status_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server instance status name",
+		},
+		"server_instance_status": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Server instance status",
+		},
+		"server_instance_operation": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Server instance operation",
+		},
+		"platform_type": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Platform type",
+		},
+		"server_instance_type": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Server instance type, e.g. standard, GPU or bare metal",
+		},
+		"internet_line_type": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Internet line type",
+		},
+		"base_block_storage_disk_type": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        commonCodeSchemaResource,
+			Description: "Base block storage disk type",
+		},
+		"zone": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        zoneSchemaResource,
+			Description: "Zone info",
+		},
+		"region": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        regionSchemaResource,
+			Description: "Region info",
+		},
+		"tag_list": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Instance tags",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tag_key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Instance tag key",
+					},
+					"tag_value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Instance tag value",
+					},
+				},
+			},
+		},
+		"create_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation date of the server instance",
+		},
+		"uptime": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Uptime of the server instance",
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -67,6 +68,18 @@ func dataSourceNcloudServerImage() *schema.Resource {
 				Optional:    true,
 				Description: "infra resource detail type code.",
 			},
+			"os_information_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+				Description:  "A regex string to apply to the OS information of the server images, e.g. `CentOS 7`.",
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If more than one server image matches, use the most recently registered one.",
+			},
 
 			"product_name": {
 				Type:        schema.TypeString,
@@ -74,7 +87,7 @@ func dataSourceNcloudServerImage() *schema.Resource {
 				Description: "Product name",
 			},
 			"product_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Product type",
@@ -85,7 +98,7 @@ func dataSourceNcloudServerImage() *schema.Resource {
 				Description: "Product description",
 			},
 			"infra_resource_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Infra resource type",
@@ -106,7 +119,7 @@ func dataSourceNcloudServerImage() *schema.Resource {
 				Description: "Base block storage size",
 			},
 			"platform_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Platform type",
@@ -135,7 +148,7 @@ func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) e
 	reqParams := &server.GetServerImageProductListRequest{
 		ExclusionProductCode:        StringPtrOrNil(d.GetOk("exclusion_product_code")),
 		ProductCode:                 StringPtrOrNil(d.GetOk("product_code")),
-		PlatformTypeCodeList:        expandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
+		PlatformTypeCodeList:        structure.ExpandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
 		RegionNo:                    regionNo,
 		InfraResourceDetailTypeCode: StringPtrOrNil(d.GetOk("infra_resource_detail_type_code")),
 	}
@@ -167,23 +180,50 @@ func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) e
 		for _, serverImage := range allServerImages {
 			if nameRegexOk && r.MatchString(ncloud.StringValue(serverImage.ProductName)) {
 				filteredServerImages = append(filteredServerImages, serverImage)
-				break
 			} else if productTypeCodeOk && productTypeCode == ncloud.StringValue(serverImage.ProductType.Code) {
 				filteredServerImages = append(filteredServerImages, serverImage)
-				break
 			}
 		}
 	}
 
+	if osRegex, ok := d.GetOk("os_information_regex"); ok {
+		filteredServerImages = filterServerImagesByOsInformation(filteredServerImages, regexp.MustCompile(osRegex.(string)))
+	}
+
 	if len(filteredServerImages) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
 	serverImage = filteredServerImages[0]
+	if d.Get("most_recent").(bool) {
+		serverImage = mostRecentServerImage(filteredServerImages)
+	}
 
 	return serverImageAttributes(d, serverImage)
 }
 
+func filterServerImagesByOsInformation(serverImages []*server.Product, r *regexp.Regexp) []*server.Product {
+	var filtered []*server.Product
+	for _, serverImage := range serverImages {
+		if r.MatchString(ncloud.StringValue(serverImage.OsInformation)) {
+			filtered = append(filtered, serverImage)
+		}
+	}
+	return filtered
+}
+
+// mostRecentServerImage returns the most recently registered server image. The API returns no registration date,
+// but server image product codes are numbered in order of registration, e.g. SPSW0LINUX000046 after SPSW0LINUX000032.
+func mostRecentServerImage(serverImages []*server.Product) *server.Product {
+	mostRecent := serverImages[0]
+	for _, serverImage := range serverImages[1:] {
+		if ncloud.StringValue(serverImage.ProductCode) > ncloud.StringValue(mostRecent.ProductCode) {
+			mostRecent = serverImage
+		}
+	}
+	return mostRecent
+}
+
 func serverImageAttributes(d *schema.ResourceData, serverImage *server.Product) error {
 	d.Set("product_code", serverImage.ProductCode)
 	d.Set("product_name", serverImage.ProductName)
@@ -194,13 +234,13 @@ func serverImageAttributes(d *schema.ResourceData, serverImage *server.Product)
 	d.Set("os_information", serverImage.OsInformation)
 	d.Set("add_block_storage_size", serverImage.AddBlockStorageSize)
 
-	if err := d.Set("product_type", flattenCommonCode(serverImage.ProductType)); err != nil {
+	if err := d.Set("product_type", structure.FlattenCommonCodeList(serverImage.ProductType)); err != nil {
 		return err
 	}
-	if err := d.Set("infra_resource_type", flattenCommonCode(serverImage.InfraResourceType)); err != nil {
+	if err := d.Set("infra_resource_type", structure.FlattenCommonCodeList(serverImage.InfraResourceType)); err != nil {
 		return err
 	}
-	if err := d.Set("platform_type", flattenCommonCode(serverImage.PlatformType)); err != nil {
+	if err := d.Set("platform_type", structure.FlattenCommonCodeList(serverImage.PlatformType)); err != nil {
 		return err
 	}
 
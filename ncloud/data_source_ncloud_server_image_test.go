@@ -1,7 +1,10 @@
 package ncloud
 
 import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
 	"testing"
 )
 
@@ -56,6 +59,56 @@ func TestAccDataSourceNcloudServerImageFilterByType(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudServerImageMostRecent(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudServerImageMostRecentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_server_image.test"),
+					resource.TestMatchResourceAttr("data.ncloud_server_image.test", "os_information", regexp.MustCompile("CentOS")),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterServerImagesByOsInformation(t *testing.T) {
+	serverImages := []*server.Product{
+		{ProductCode: ncloud.String("SPSW0LINUX000032"), OsInformation: ncloud.String("CentOS 7.3 (64-bit)")},
+		{ProductCode: ncloud.String("SPSW0LINUX000031"), OsInformation: ncloud.String("Ubuntu Server 16.04 (64-bit)")},
+	}
+
+	r := filterServerImagesByOsInformation(serverImages, regexp.MustCompile("^CentOS"))
+	if len(r) != 1 || r[0] != serverImages[0] {
+		t.Fatalf("expected only the CentOS image, but was %v", r)
+	}
+}
+
+func TestMostRecentServerImage(t *testing.T) {
+	serverImages := []*server.Product{
+		{ProductCode: ncloud.String("SPSW0LINUX000032")},
+		{ProductCode: ncloud.String("SPSW0LINUX000046")},
+		{ProductCode: ncloud.String("SPSW0LINUX000045")},
+	}
+
+	if r := mostRecentServerImage(serverImages); r != serverImages[1] {
+		t.Fatalf("expected SPSW0LINUX000046, but was %s", ncloud.StringValue(r.ProductCode))
+	}
+}
+
+var testAccDataSourceNcloudServerImageMostRecentConfig = `
+data "ncloud_server_image" "test" {
+	"platform_type_code_list" = ["LNX64"]
+	"os_information_regex" = "^CentOS 7"
+	"most_recent" = true
+}
+`
+
 var testAccDataSourceNcloudServerImageConfig = `
 data "ncloud_server_image" "test" {
 }
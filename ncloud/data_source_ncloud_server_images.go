@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -77,7 +78,7 @@ func dataSourceNcloudServerImages() *schema.Resource {
 							Description: "Product name",
 						},
 						"product_type": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Product type",
@@ -88,7 +89,7 @@ func dataSourceNcloudServerImages() *schema.Resource {
 							Description: "Product description",
 						},
 						"infra_resource_type": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Infra resource type",
@@ -109,7 +110,7 @@ func dataSourceNcloudServerImages() *schema.Resource {
 							Description: "Base block storage size",
 						},
 						"platform_type": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Computed:    true,
 							Elem:        commonCodeSchemaResource,
 							Description: "Platform type",
@@ -145,7 +146,7 @@ func dataSourceNcloudServerImagesRead(d *schema.ResourceData, meta interface{})
 	reqParams := &server.GetServerImageProductListRequest{
 		ExclusionProductCode:        StringPtrOrNil(d.GetOk("exclusion_product_code")),
 		ProductCode:                 StringPtrOrNil(d.GetOk("product_code")),
-		PlatformTypeCodeList:        expandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
+		PlatformTypeCodeList:        structure.ExpandStringInterfaceList(d.Get("platform_type_code_list").([]interface{})),
 		RegionNo:                    regionNo,
 		InfraResourceDetailTypeCode: StringPtrOrNil(d.GetOk("infra_resource_detail_type_code")),
 	}
@@ -0,0 +1,195 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNcloudServerInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudServerInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+				Description:  "A regex string to apply to the names of the server instances",
+			},
+			"server_instance_status_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{"INIT", "CREAT", "RUN", "NSTOP"}),
+				Description:  "Server instance status code. Accepted values: INIT | CREAT | RUN | NSTOP",
+			},
+			"tag_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of an instance tag the server instances must have",
+			},
+			"tag_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the `tag_key` instance tag the server instances must have",
+			},
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"zone_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone code. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_no"},
+			},
+			"zone_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Zone number. Get available values using the `data ncloud_zones`.",
+				ConflictsWith: []string{"zone_code"},
+			},
+			"import_resource_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ncloud_server.imported",
+				Description: "Address of the counted `ncloud_server` resource to import the server instances into",
+			},
+
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Server instance numbers of the server instances",
+			},
+			"server_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: serverInstanceDataSourceSchema()},
+				Description: "A list of server instances",
+			},
+			"import_commands": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "terraform import commands importing each server instance into `import_resource_address`, in the order of `ids`",
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudServerInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+	zoneNo, err := parseZoneNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+
+	reqParams := &server.GetServerInstanceListRequest{
+		ServerInstanceStatusCode: StringPtrOrNil(d.GetOk("server_instance_status_code")),
+		RegionNo:                 regionNo,
+		ZoneNo:                   zoneNo,
+	}
+	if tagKey, ok := d.GetOk("tag_key"); ok {
+		reqParams.TagKeyList = []*string{ncloud.String(tagKey.(string))}
+	}
+
+	serverInstances, err := getServerInstanceList(client, reqParams)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("server_name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	filteredServerInstances := filterServerInstances(serverInstances, nameRegex, d.Get("tag_key").(string), d.Get("tag_value").(string))
+
+	if len(filteredServerInstances) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	return serverInstancesAttributes(d, filteredServerInstances)
+}
+
+// filterServerInstances keeps the server instances whose name matches nameRegex and that have the tagKey instance tag
+// with the value tagValue. An empty filter matches every server instance.
+func filterServerInstances(serverInstances []*server.ServerInstance, nameRegex *regexp.Regexp, tagKey string, tagValue string) []*server.ServerInstance {
+	var filtered []*server.ServerInstance
+	for _, instance := range serverInstances {
+		if nameRegex != nil && !nameRegex.MatchString(ncloud.StringValue(instance.ServerName)) {
+			continue
+		}
+		if tagKey != "" && !hasInstanceTag(instance.InstanceTagList, tagKey, tagValue) {
+			continue
+		}
+		filtered = append(filtered, instance)
+	}
+	return filtered
+}
+
+func hasInstanceTag(tagList []*server.InstanceTag, tagKey string, tagValue string) bool {
+	for _, tag := range tagList {
+		if ncloud.StringValue(tag.TagKey) == tagKey && (tagValue == "" || ncloud.StringValue(tag.TagValue) == tagValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func serverInstancesAttributes(d *schema.ResourceData, serverInstances []*server.ServerInstance) error {
+	var ids []string
+	var s []map[string]interface{}
+
+	for _, instance := range serverInstances {
+		ids = append(ids, ncloud.StringValue(instance.ServerInstanceNo))
+		s = append(s, flattenServerInstance(instance))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("server_instances", s); err != nil {
+		return err
+	}
+	if err := d.Set("import_commands", importCommands(d.Get("import_resource_address").(string), ids)); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("server_instances"))
+	}
+
+	return nil
+}
+
+// importCommands returns a terraform import command for each instance number,
+// importing it at its index into the counted resource at address.
+func importCommands(address string, ids []string) []string {
+	commands := make([]string, 0, len(ids))
+	for i, id := range ids {
+		commands = append(commands, fmt.Sprintf("terraform import '%s[%d]' %s", address, i, id))
+	}
+	return commands
+}
@@ -0,0 +1,96 @@
+package ncloud
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudServerInstancesBasic(t *testing.T) {
+	testServerName := fmt.Sprintf("tf-ds-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudServerInstancesConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_server_instances.servers"),
+					resource.TestCheckResourceAttr("data.ncloud_server_instances.servers", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ncloud_server_instances.servers", "ids.0", "ncloud_server.server", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudServerInstancesConfig(testServerName string) string {
+	return testAccServerConfig(testServerName) + fmt.Sprintf(`
+data "ncloud_server_instances" "servers" {
+	"server_name_regex" = "^%s$"
+	"server_instance_status_code" = "RUN"
+	"depends_on" = ["ncloud_server.server"]
+}
+`, testServerName)
+}
+
+func TestFilterServerInstances(t *testing.T) {
+	serverInstances := []*server.ServerInstance{
+		{
+			ServerInstanceNo: ncloud.String("1"),
+			ServerName:       ncloud.String("web-1"),
+			InstanceTagList:  []*server.InstanceTag{{TagKey: ncloud.String("env"), TagValue: ncloud.String("prod")}},
+		},
+		{
+			ServerInstanceNo: ncloud.String("2"),
+			ServerName:       ncloud.String("web-2"),
+			InstanceTagList:  []*server.InstanceTag{{TagKey: ncloud.String("env"), TagValue: ncloud.String("dev")}},
+		},
+		{
+			ServerInstanceNo: ncloud.String("3"),
+			ServerName:       ncloud.String("db-1"),
+		},
+	}
+
+	cases := []struct {
+		nameRegex *regexp.Regexp
+		tagKey    string
+		tagValue  string
+		expected  []string
+	}{
+		{nil, "", "", []string{"1", "2", "3"}},
+		{regexp.MustCompile("^web-"), "", "", []string{"1", "2"}},
+		{nil, "env", "", []string{"1", "2"}},
+		{nil, "env", "prod", []string{"1"}},
+		{regexp.MustCompile("^db-"), "env", "", nil},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, instance := range filterServerInstances(serverInstances, c.nameRegex, c.tagKey, c.tagValue) {
+			ids = append(ids, ncloud.StringValue(instance.ServerInstanceNo))
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Fatalf("expected %v for regex %v, tag %s=%s, got %v", c.expected, c.nameRegex, c.tagKey, c.tagValue, ids)
+		}
+	}
+}
+
+func TestImportCommands(t *testing.T) {
+	r := importCommands("ncloud_server.web", []string{"812345", "812346"})
+
+	expected := []string{
+		"terraform import 'ncloud_server.web[0]' 812345",
+		"terraform import 'ncloud_server.web[1]' 812346",
+	}
+	if !reflect.DeepEqual(r, expected) {
+		t.Fatalf("expected %v, but was %v", expected, r)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -74,7 +75,7 @@ func dataSourceNcloudServerProduct() *schema.Resource {
 				Description: "Product name",
 			},
 			"product_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Product type",
@@ -85,7 +86,7 @@ func dataSourceNcloudServerProduct() *schema.Resource {
 				Description: "Product description",
 			},
 			"infra_resource_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Infra resource type",
@@ -106,7 +107,7 @@ func dataSourceNcloudServerProduct() *schema.Resource {
 				Description: "Base block storage size",
 			},
 			"platform_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Platform type",
@@ -188,13 +189,13 @@ func serverProductAttributes(d *schema.ResourceData, product *server.Product) er
 	d.Set("os_information", product.OsInformation)
 	d.Set("add_block_storage_size", product.AddBlockStorageSize)
 
-	if err := d.Set("product_type", flattenCommonCode(product.ProductType)); err != nil {
+	if err := d.Set("product_type", structure.FlattenCommonCodeList(product.ProductType)); err != nil {
 		return err
 	}
-	if err := d.Set("infra_resource_type", flattenCommonCode(product.InfraResourceType)); err != nil {
+	if err := d.Set("infra_resource_type", structure.FlattenCommonCodeList(product.InfraResourceType)); err != nil {
 		return err
 	}
-	if err := d.Set("platform_type", flattenCommonCode(product.PlatformType)); err != nil {
+	if err := d.Set("platform_type", structure.FlattenCommonCodeList(product.PlatformType)); err != nil {
 		return err
 	}
 
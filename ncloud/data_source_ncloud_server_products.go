@@ -3,12 +3,16 @@ package ncloud
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// GiB is the number of bytes of the memory size of a server product per GB.
+const GiB = 1024 * 1024 * 1024
+
 func dataSourceNcloudServerProducts() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceNcloudServerProductsRead,
@@ -68,6 +72,29 @@ func dataSourceNcloudServerProducts() *schema.Resource {
 				ValidateFunc: validateInternetLineTypeCode,
 				Description:  "Internet line identification code. PUBLC(Public), GLBL(Global). default : PUBLC(Public)",
 			},
+			"min_cpu_count": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum CPU count of the server products",
+			},
+			"min_memory_size_gb": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum memory size of the server products in GB",
+			},
+			"base_block_storage_disk_detail_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIncludeValues([]string{ServerDiskDetailTypeSSD, ServerDiskDetailTypeHDD}),
+				Description:  "Disk detail type of the base block storage of the server products. SSD | HDD",
+			},
+			"selection": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateIncludeValues([]string{"all", "smallest"}),
+				Description:  "all: every matching server product. smallest: sort the server products by memory, CPU and base block storage size, and set `product_code` to the smallest one. default: all",
+			},
 			"server_products": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -82,7 +109,7 @@ func dataSourceNcloudServerProducts() *schema.Resource {
 							Computed: true,
 						},
 						"product_type": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -91,7 +118,7 @@ func dataSourceNcloudServerProducts() *schema.Resource {
 							Computed: true,
 						},
 						"infra_resource_type": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -108,7 +135,7 @@ func dataSourceNcloudServerProducts() *schema.Resource {
 							Computed: true,
 						},
 						"platform_type": {
-							Type:     schema.TypeMap,
+							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     commonCodeSchemaResource,
 						},
@@ -174,13 +201,57 @@ func dataSourceNcloudServerProductsRead(d *schema.ResourceData, meta interface{}
 		filteredServerProducts = allServerProducts[:]
 	}
 
+	filteredServerProducts = filterServerProductsBySpec(filteredServerProducts,
+		d.Get("min_cpu_count").(int),
+		d.Get("min_memory_size_gb").(int),
+		d.Get("base_block_storage_disk_detail_type_code").(string))
+
 	if len(filteredServerProducts) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	if d.Get("selection").(string) == "smallest" {
+		sortServerProductsBySize(filteredServerProducts)
+		d.Set("product_code", filteredServerProducts[0].ProductCode)
+	}
+
 	return serverProductsAttributes(d, filteredServerProducts)
 }
 
+// filterServerProductsBySpec keeps the server products with at least minCpuCount CPUs and minMemorySizeGb GB of memory,
+// and with the given base block storage disk detail type. Zero values match every server product.
+func filterServerProductsBySpec(products []*server.Product, minCpuCount int, minMemorySizeGb int, diskDetailType string) []*server.Product {
+	var filtered []*server.Product
+	for _, product := range products {
+		if int(ncloud.Int32Value(product.CpuCount)) < minCpuCount {
+			continue
+		}
+		if ncloud.Int64Value(product.MemorySize) < int64(minMemorySizeGb)*GiB {
+			continue
+		}
+		if diskDetailType != "" && serverProductDiskDetailType(ncloud.StringValue(product.ProductCode)) != diskDetailType {
+			continue
+		}
+		filtered = append(filtered, product)
+	}
+	return filtered
+}
+
+// sortServerProductsBySize sorts the server products by memory size, CPU count and base block storage size,
+// the criteria the API uses to select the default (minimum) server product.
+func sortServerProductsBySize(products []*server.Product) {
+	sort.SliceStable(products, func(i, j int) bool {
+		a, b := products[i], products[j]
+		if ncloud.Int64Value(a.MemorySize) != ncloud.Int64Value(b.MemorySize) {
+			return ncloud.Int64Value(a.MemorySize) < ncloud.Int64Value(b.MemorySize)
+		}
+		if ncloud.Int32Value(a.CpuCount) != ncloud.Int32Value(b.CpuCount) {
+			return ncloud.Int32Value(a.CpuCount) < ncloud.Int32Value(b.CpuCount)
+		}
+		return ncloud.Int64Value(a.BaseBlockStorageSize) < ncloud.Int64Value(b.BaseBlockStorageSize)
+	})
+}
+
 func serverProductsAttributes(d *schema.ResourceData, serverImages []*server.Product) error {
 	var ids []string
 
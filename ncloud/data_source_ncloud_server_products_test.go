@@ -1,6 +1,8 @@
 package ncloud
 
 import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"testing"
 )
@@ -26,8 +28,82 @@ func TestAccDataSourceNcloudServerProductsBasic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudServerProductsSmallest(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudServerProductsSmallestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_server_products.smallest"),
+					resource.TestCheckResourceAttrSet("data.ncloud_server_products.smallest", "product_code"),
+					resource.TestCheckResourceAttrPair(
+						"data.ncloud_server_products.smallest", "product_code",
+						"data.ncloud_server_products.smallest", "server_products.0.product_code"),
+				),
+			},
+		},
+	})
+}
+
+func testServerProduct(code string, cpuCount int32, memorySizeGb int64, baseBlockStorageSizeGb int64) *server.Product {
+	return &server.Product{
+		ProductCode:          ncloud.String(code),
+		CpuCount:             ncloud.Int32(cpuCount),
+		MemorySize:           ncloud.Int64(memorySizeGb * GiB),
+		BaseBlockStorageSize: ncloud.Int64(baseBlockStorageSizeGb * GiB),
+	}
+}
+
+func TestFilterServerProductsBySpec(t *testing.T) {
+	products := []*server.Product{
+		testServerProduct("SPSVRSTAND000056", 1, 1, 50),
+		testServerProduct("SPSVRSTAND000004", 2, 4, 50),
+		testServerProduct("SPSVRSSD00000005", 2, 4, 50),
+	}
+
+	r := filterServerProductsBySpec(products, 2, 4, ServerDiskDetailTypeHDD)
+	if len(r) != 1 || r[0] != products[1] {
+		t.Fatalf("expected only SPSVRSTAND000004, but was %v", r)
+	}
+
+	if r := filterServerProductsBySpec(products, 0, 0, ""); len(r) != 3 {
+		t.Fatalf("expected every product, but got %d", len(r))
+	}
+}
+
+func TestSortServerProductsBySize(t *testing.T) {
+	products := []*server.Product{
+		testServerProduct("SPSVRSTAND000006", 4, 8, 50),
+		testServerProduct("SPSVRSTAND000049", 2, 4, 100),
+		testServerProduct("SPSVRSTAND000004", 2, 4, 50),
+		testServerProduct("SPSVRSTAND000003", 4, 4, 50),
+	}
+
+	sortServerProductsBySize(products)
+
+	expected := []string{"SPSVRSTAND000004", "SPSVRSTAND000049", "SPSVRSTAND000003", "SPSVRSTAND000006"}
+	for i, code := range expected {
+		if ncloud.StringValue(products[i].ProductCode) != code {
+			t.Fatalf("expected %s at %d, but was %s", code, i, ncloud.StringValue(products[i].ProductCode))
+		}
+	}
+}
+
 var testAccDataSourceNcloudServerProductsConfig = `
 data "ncloud_server_products" "all" {
 	"server_image_product_code" = "SPSW0LINUX000032"
 }
 `
+
+var testAccDataSourceNcloudServerProductsSmallestConfig = `
+data "ncloud_server_products" "smallest" {
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"min_cpu_count" = 2
+	"min_memory_size_gb" = 4
+	"selection" = "smallest"
+}
+`
@@ -0,0 +1,41 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudServerBasic(t *testing.T) {
+	testServerName := fmt.Sprintf("tf-ds-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudServerConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_server.by_name"),
+					resource.TestCheckResourceAttrPair("data.ncloud_server.by_name", "server_instance_no", "ncloud_server.server", "id"),
+					resource.TestCheckResourceAttrPair("data.ncloud_server.by_no", "server_name", "ncloud_server.server", "server_name"),
+					resource.TestCheckResourceAttrPair("data.ncloud_server.by_no", "private_ip", "ncloud_server.server", "private_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudServerConfig(testServerName string) string {
+	return testAccServerConfig(testServerName) + `
+data "ncloud_server" "by_name" {
+	"server_name" = "${ncloud_server.server.server_name}"
+}
+
+data "ncloud_server" "by_no" {
+	"server_instance_no" = "${ncloud_server.server.id}"
+}
+`
+}
@@ -0,0 +1,205 @@
+package ncloud
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Names of the quotas of `data ncloud_service_quotas`
+const (
+	ServiceQuotaServer       = "server"
+	ServiceQuotaPublicIp     = "public_ip"
+	ServiceQuotaLoadBalancer = "load_balancer"
+	ServiceQuotaBlockStorage = "block_storage"
+)
+
+var serviceQuotaNames = []string{ServiceQuotaServer, ServiceQuotaPublicIp, ServiceQuotaLoadBalancer, ServiceQuotaBlockStorage}
+
+func dataSourceNcloudServiceQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudServiceQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Region code. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_no"},
+			},
+			"region_no": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Region number. Get available values using the `data ncloud_regions`.",
+				ConflictsWith: []string{"region_code"},
+			},
+			"limits": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Limits of the account by quota name (server, public_ip, load_balancer, block_storage). The API does not expose the limits, so they are given here to compute `available`.",
+			},
+
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Quota name",
+						},
+						"usage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of instances in use in the region",
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Limit given in `limits`, or -1 when none is given",
+						},
+						"available": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of instances that can still be created, or -1 when no limit is given",
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceNcloudServiceQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	regionNo, err := parseRegionNoParameter(client, d)
+	if err != nil {
+		return err
+	}
+
+	limits := d.Get("limits").(map[string]interface{})
+	if err := validateServiceQuotaLimits(limits); err != nil {
+		return err
+	}
+
+	usage, err := getServiceQuotaUsage(client, regionNo)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("region_no", regionNo)
+	if err := d.Set("quotas", flattenServiceQuotas(usage, limits)); err != nil {
+		return err
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), d.Get("quotas"))
+	}
+
+	return nil
+}
+
+func validateServiceQuotaLimits(limits map[string]interface{}) error {
+	for name := range limits {
+		known := false
+		for _, quotaName := range serviceQuotaNames {
+			if name == quotaName {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown quota name %q in limits. it must be one of %v", name, serviceQuotaNames)
+		}
+	}
+	return nil
+}
+
+// getServiceQuotaUsage counts the instances of each quota in the region.
+func getServiceQuotaUsage(client *NcloudAPIClient, regionNo *string) (map[string]int, error) {
+	usage := make(map[string]int, len(serviceQuotaNames))
+
+	serverReqParams := &server.GetServerInstanceListRequest{RegionNo: regionNo, PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(1)}
+	logCommonRequest("GetServerInstanceList", serverReqParams)
+	serverResp, err := client.server.V2Api.GetServerInstanceList(serverReqParams)
+	if err != nil {
+		logErrorResponse("GetServerInstanceList", err, serverReqParams)
+		return nil, err
+	}
+	logCommonResponse("GetServerInstanceList", GetCommonResponse(serverResp))
+	usage[ServiceQuotaServer] = int(ncloud.Int32Value(serverResp.TotalRows))
+
+	publicIpReqParams := &server.GetPublicIpInstanceListRequest{RegionNo: regionNo, PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(1)}
+	logCommonRequest("GetPublicIpInstanceList", publicIpReqParams)
+	publicIpResp, err := client.server.V2Api.GetPublicIpInstanceList(publicIpReqParams)
+	if err != nil {
+		logErrorResponse("GetPublicIpInstanceList", err, publicIpReqParams)
+		return nil, err
+	}
+	logCommonResponse("GetPublicIpInstanceList", GetCommonResponse(publicIpResp))
+	usage[ServiceQuotaPublicIp] = int(ncloud.Int32Value(publicIpResp.TotalRows))
+
+	loadBalancerReqParams := &loadbalancer.GetLoadBalancerInstanceListRequest{RegionNo: regionNo, PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(1)}
+	logCommonRequest("GetLoadBalancerInstanceList", loadBalancerReqParams)
+	loadBalancerResp, err := client.loadbalancer.V2Api.GetLoadBalancerInstanceList(loadBalancerReqParams)
+	if err != nil {
+		logErrorResponse("GetLoadBalancerInstanceList", err, loadBalancerReqParams)
+		return nil, err
+	}
+	logCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(loadBalancerResp))
+	usage[ServiceQuotaLoadBalancer] = int(ncloud.Int32Value(loadBalancerResp.TotalRows))
+
+	blockStorageReqParams := &server.GetBlockStorageInstanceListRequest{RegionNo: regionNo, PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(1)}
+	logCommonRequest("GetBlockStorageInstanceList", blockStorageReqParams)
+	blockStorageResp, err := client.server.V2Api.GetBlockStorageInstanceList(blockStorageReqParams)
+	if err != nil {
+		logErrorResponse("GetBlockStorageInstanceList", err, blockStorageReqParams)
+		return nil, err
+	}
+	logCommonResponse("GetBlockStorageInstanceList", GetCommonResponse(blockStorageResp))
+	usage[ServiceQuotaBlockStorage] = int(ncloud.Int32Value(blockStorageResp.TotalRows))
+
+	return usage, nil
+}
+
+// flattenServiceQuotas returns the quotas sorted by name. A quota without a limit has limit and available set to -1.
+func flattenServiceQuotas(usage map[string]int, limits map[string]interface{}) []map[string]interface{} {
+	names := make([]string, 0, len(usage))
+	for name := range usage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var s []map[string]interface{}
+	for _, name := range names {
+		limit, available := -1, -1
+		if v, ok := limits[name]; ok {
+			limit = v.(int)
+			available = limit - usage[name]
+			if available < 0 {
+				available = 0
+			}
+		}
+		s = append(s, map[string]interface{}{
+			"name":      name,
+			"usage":     usage[name],
+			"limit":     limit,
+			"available": available,
+		})
+	}
+	return s
+}
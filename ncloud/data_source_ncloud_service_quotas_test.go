@@ -0,0 +1,68 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceNcloudServiceQuotasBasic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudServiceQuotasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID("data.ncloud_service_quotas.quotas"),
+					resource.TestCheckResourceAttr("data.ncloud_service_quotas.quotas", "quotas.#", "4"),
+					resource.TestCheckResourceAttr("data.ncloud_service_quotas.quotas", "quotas.3.name", "server"),
+					resource.TestCheckResourceAttr("data.ncloud_service_quotas.quotas", "quotas.3.limit", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenServiceQuotas(t *testing.T) {
+	usage := map[string]int{
+		ServiceQuotaServer:   48,
+		ServiceQuotaPublicIp: 3,
+	}
+	limits := map[string]interface{}{
+		ServiceQuotaServer: 50,
+	}
+
+	r := flattenServiceQuotas(usage, limits)
+
+	if len(r) != 2 {
+		t.Fatalf("expected 2 quotas, but got %d", len(r))
+	}
+	if r[0]["name"] != ServiceQuotaPublicIp || r[0]["limit"] != -1 || r[0]["available"] != -1 {
+		t.Fatalf("expected public_ip quota without a limit, but was %v", r[0])
+	}
+	if r[1]["name"] != ServiceQuotaServer || r[1]["usage"] != 48 || r[1]["available"] != 2 {
+		t.Fatalf("expected server quota with 2 available, but was %v", r[1])
+	}
+}
+
+func TestValidateServiceQuotaLimits(t *testing.T) {
+	if err := validateServiceQuotaLimits(map[string]interface{}{ServiceQuotaLoadBalancer: 10}); err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+	if err := validateServiceQuotaLimits(map[string]interface{}{"nat_gateway": 10}); err == nil {
+		t.Fatalf("expected an error for an unknown quota name")
+	}
+}
+
+var testAccDataSourceNcloudServiceQuotasConfig = `
+data "ncloud_service_quotas" "quotas" {
+	"region_code" = "KR"
+
+	"limits" = {
+		"server" = 50
+	}
+}
+`
@@ -0,0 +1,50 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+)
+
+// resourceChangeReader is the part of *schema.ResourceDiff read to detect disruptive changes.
+type resourceChangeReader interface {
+	Id() string
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// disruptiveChange is an in-place update that interrupts the service of a resource, e.g. by stopping a server.
+type disruptiveChange struct {
+	Key    string
+	Impact string
+	// Applies reports whether the change of Key is disruptive. nil means any change is.
+	Applies func(diff resourceChangeReader) bool
+}
+
+// disruptiveChangeWarnings returns a warning for each disruptive change planned on an existing resource.
+func disruptiveChangeWarnings(diff resourceChangeReader, changes []disruptiveChange) []string {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	var warnings []string
+	for _, change := range changes {
+		if !diff.HasChange(change.Key) {
+			continue
+		}
+		if change.Applies != nil && !change.Applies(diff) {
+			continue
+		}
+		o, n := diff.GetChange(change.Key)
+		warnings = append(warnings, fmt.Sprintf("changing %s from %v to %v %s", change.Key, o, n, change.Impact))
+	}
+	return warnings
+}
+
+// warnDisruptiveChanges logs the disruptive changes planned on an existing resource during plan.
+// Terraform 0.11 has no warning diagnostics for a plan, so they are written to the log at WARN level.
+func warnDisruptiveChanges(resourceType string, diff resourceChangeReader, changes []disruptiveChange) {
+	for _, warning := range disruptiveChangeWarnings(diff, changes) {
+		log.Printf("[WARN] %s [%s]: %s", resourceType, diff.Id(), warning)
+	}
+}
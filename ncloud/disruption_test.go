@@ -0,0 +1,48 @@
+package ncloud
+
+import (
+	"testing"
+)
+
+type testResourceChange struct {
+	id      string
+	old     map[string]interface{}
+	new     map[string]interface{}
+	changed map[string]bool
+}
+
+func (c *testResourceChange) Id() string                 { return c.id }
+func (c *testResourceChange) Get(key string) interface{} { return c.new[key] }
+func (c *testResourceChange) HasChange(key string) bool  { return c.changed[key] }
+func (c *testResourceChange) GetChange(key string) (interface{}, interface{}) {
+	return c.old[key], c.new[key]
+}
+
+func TestDisruptiveChangeWarnings(t *testing.T) {
+	changes := []disruptiveChange{
+		{Key: "server_product_code", Impact: "stops and restarts the server"},
+		{
+			Key:    "state",
+			Impact: "stops the server",
+			Applies: func(diff resourceChangeReader) bool {
+				return diff.Get("state") == ServerStateStopped
+			},
+		},
+	}
+
+	diff := &testResourceChange{
+		id:      "1",
+		old:     map[string]interface{}{"server_product_code": "A", "state": ServerStateStopped},
+		new:     map[string]interface{}{"server_product_code": "B", "state": ServerStateRunning},
+		changed: map[string]bool{"server_product_code": true, "state": true},
+	}
+	warnings := disruptiveChangeWarnings(diff, changes)
+	if len(warnings) != 1 || warnings[0] != "changing server_product_code from A to B stops and restarts the server" {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+
+	diff.id = ""
+	if warnings := disruptiveChangeWarnings(diff, changes); len(warnings) != 0 {
+		t.Fatalf("expected no warning for a new resource, got %v", warnings)
+	}
+}
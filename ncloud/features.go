@@ -0,0 +1,114 @@
+package ncloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Features holds the provider level opt-in behaviors configured in the `features` block.
+type Features struct {
+	Server   ServerFeatures
+	PublicIp PublicIpFeatures
+}
+
+type ServerFeatures struct {
+	// DetachBlockStorageOnDestroy detaches additional block storages before the server is terminated,
+	// instead of returning them together with the server.
+	DetachBlockStorageOnDestroy bool
+	// DeregisterFromLoadBalancersOnDestroy removes the server from the load balancers it is bound to before it is terminated.
+	// When false, destroying a server still bound to a load balancer fails, including when the server is replaced.
+	DeregisterFromLoadBalancersOnDestroy bool
+}
+
+type PublicIpFeatures struct {
+	// ReleaseOnDestroy deletes the public IP on destroy. When false it is only disassociated and kept in the account.
+	ReleaseOnDestroy bool
+}
+
+func defaultFeatures() Features {
+	return Features{
+		Server: ServerFeatures{
+			DetachBlockStorageOnDestroy:          true,
+			DeregisterFromLoadBalancersOnDestroy: true,
+		},
+		PublicIp: PublicIpFeatures{
+			ReleaseOnDestroy: true,
+		},
+	}
+}
+
+func featuresSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["features"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"server": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"detach_block_storage_on_destroy": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Detach additional block storages before terminating a server instead of returning them together with the server.",
+							},
+							"deregister_from_load_balancers_on_destroy": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Remove a server from the load balancers it is bound to before terminating it. If false, destroying or replacing a server still bound to a load balancer fails.",
+							},
+						},
+					},
+				},
+				"public_ip": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"release_on_destroy": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Delete the public IP on destroy. If false, the public IP is only disassociated and kept in the account.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) Features {
+	features := defaultFeatures()
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	if items, ok := raw["server"].([]interface{}); ok && len(items) > 0 && items[0] != nil {
+		server := items[0].(map[string]interface{})
+		if v, ok := server["detach_block_storage_on_destroy"]; ok {
+			features.Server.DetachBlockStorageOnDestroy = v.(bool)
+		}
+		if v, ok := server["deregister_from_load_balancers_on_destroy"]; ok {
+			features.Server.DeregisterFromLoadBalancersOnDestroy = v.(bool)
+		}
+	}
+
+	if items, ok := raw["public_ip"].([]interface{}); ok && len(items) > 0 && items[0] != nil {
+		publicIp := items[0].(map[string]interface{})
+		if v, ok := publicIp["release_on_destroy"]; ok {
+			features.PublicIp.ReleaseOnDestroy = v.(bool)
+		}
+	}
+
+	return features
+}
@@ -0,0 +1,85 @@
+package ncloud
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	cases := map[string]struct {
+		Input    []interface{}
+		Expected Features
+	}{
+		"empty": {
+			Input:    []interface{}{},
+			Expected: defaultFeatures(),
+		},
+		"empty block": {
+			Input:    []interface{}{nil},
+			Expected: defaultFeatures(),
+		},
+		"empty nested blocks": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"server":    []interface{}{},
+					"public_ip": []interface{}{},
+				},
+			},
+			Expected: defaultFeatures(),
+		},
+		"opt in": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"server": []interface{}{
+						map[string]interface{}{
+							"detach_block_storage_on_destroy":           true,
+							"deregister_from_load_balancers_on_destroy": true,
+						},
+					},
+				},
+			},
+			Expected: Features{
+				Server: ServerFeatures{
+					DetachBlockStorageOnDestroy:          true,
+					DeregisterFromLoadBalancersOnDestroy: true,
+				},
+				PublicIp: PublicIpFeatures{
+					ReleaseOnDestroy: true,
+				},
+			},
+		},
+		"opt out": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"server": []interface{}{
+						map[string]interface{}{
+							"detach_block_storage_on_destroy":           false,
+							"deregister_from_load_balancers_on_destroy": false,
+						},
+					},
+					"public_ip": []interface{}{
+						map[string]interface{}{
+							"release_on_destroy": false,
+						},
+					},
+				},
+			},
+			Expected: Features{
+				Server: ServerFeatures{
+					DetachBlockStorageOnDestroy:          false,
+					DeregisterFromLoadBalancersOnDestroy: false,
+				},
+				PublicIp: PublicIpFeatures{
+					ReleaseOnDestroy: false,
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		result := expandFeatures(tc.Input)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("bad: %s\n\nGot:\n\n%#v\n\nExpected:\n\n%#v\n", tn, result, tc.Expected)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// knownString returns the planned value of a string argument, unless it is empty or only known after apply.
+func knownString(diff *schema.ResourceDiff, key string) (string, bool) {
+	if !diff.NewValueKnown(key) {
+		return "", false
+	}
+	v, ok := diff.GetOk(key)
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// preflightServerCreate checks a server to create against the API when `preflight_validation` is enabled,
+// and reports every problem found at plan time instead of the first one failing during apply.
+// Arguments only known after apply are not checked.
+func preflightServerCreate(client *NcloudAPIClient, diff *schema.ResourceDiff) error {
+	var errs *multierror.Error
+
+	if name, ok := knownString(diff, "server_name"); ok && !diff.Get("server_name_random_suffix").(bool) {
+		if !diff.Get("ignore_resource_name_affixes").(bool) {
+			name = client.resourceNamePrefix + name + client.resourceNameSuffix
+		}
+		if err := preflightServerNameAvailable(client, name); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if name, ok := knownString(diff, "member_server_image_name"); ok {
+		if _, err := getMemberServerImageNoByName(client, name); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	imageCode, imageOk := knownString(diff, "server_image_product_code")
+	productCode, productOk := knownString(diff, "server_product_code")
+	if imageOk && productOk {
+		zoneNo, zoneOk := preflightZoneNo(client, diff)
+		if zoneOk {
+			if err := preflightServerProductAvailable(client, imageCode, productCode, zoneNo); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+
+	if raidTypeName, ok := knownString(diff, "raid_type_name"); ok {
+		if err := validateRaidTypeName(client, raidTypeName); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// preflightZoneNo returns the zone of the server to create, or nil for the default zone.
+// It returns false when the zone is only known after apply.
+func preflightZoneNo(client *NcloudAPIClient, diff *schema.ResourceDiff) (*string, bool) {
+	if !diff.NewValueKnown("zone_no") || !diff.NewValueKnown("zone_code") {
+		return nil, false
+	}
+	if zoneNo, ok := knownString(diff, "zone_no"); ok {
+		return ncloud.String(zoneNo), true
+	}
+	if zoneCode, ok := knownString(diff, "zone_code"); ok {
+		zoneNo := getZoneNoByCode(client, zoneCode)
+		if zoneNo == "" {
+			log.Printf("[WARN] preflight validation: no zone data for zone_code `%s`", zoneCode)
+			return nil, false
+		}
+		return ncloud.String(zoneNo), true
+	}
+	return nil, true
+}
+
+func preflightServerNameAvailable(client *NcloudAPIClient, name string) error {
+	serverInstances, err := getServerInstanceList(client, &server.GetServerInstanceListRequest{
+		SearchFilterName:  ncloud.String("serverName"),
+		SearchFilterValue: ncloud.String(name),
+	})
+	if err != nil {
+		return err
+	}
+	for _, instance := range serverInstances {
+		// The server name search filter matches partial names.
+		if ncloud.StringValue(instance.ServerName) == name {
+			return fmt.Errorf("server_name %q is already used by server instance [%s]", name, ncloud.StringValue(instance.ServerInstanceNo))
+		}
+	}
+	return nil
+}
+
+func preflightServerProductAvailable(client *NcloudAPIClient, serverImageProductCode string, serverProductCode string, zoneNo *string) error {
+	reqParams := &server.GetServerProductListRequest{
+		ServerImageProductCode: ncloud.String(serverImageProductCode),
+		ProductCode:            ncloud.String(serverProductCode),
+		ZoneNo:                 zoneNo,
+	}
+
+	logCommonRequest("GetServerProductList", reqParams)
+	resp, err := client.server.V2Api.GetServerProductList(reqParams)
+	if err != nil {
+		logErrorResponse("GetServerProductList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetServerProductList", GetCommonResponse(resp))
+
+	for _, product := range resp.ProductList {
+		if ncloud.StringValue(product.ProductCode) == serverProductCode {
+			return nil
+		}
+	}
+	return fmt.Errorf("server_product_code %s is not available for server_image_product_code %s in the zone", serverProductCode, serverImageProductCode)
+}
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,53 +13,96 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_ACCESS_KEY", os.Getenv("NCLOUD_ACCESS_KEY")),
 				Description: descriptions["access_key"],
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SECRET_KEY", os.Getenv("NCLOUD_SECRET_KEY")),
 				Description: descriptions["secret_key"],
 			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_CREDENTIAL_PROCESS", nil),
+				Description: descriptions["credential_process"],
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_REGION", os.Getenv("NCLOUD_REGION")),
 				Description: descriptions["region"],
 			},
+			"enable_http2": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_ENABLE_HTTP2", false),
+				Description: descriptions["enable_http2"],
+			},
+			"preflight_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_PREFLIGHT_VALIDATION", false),
+				Description: descriptions["preflight_validation"],
+			},
+			"resource_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_RESOURCE_NAME_PREFIX", ""),
+				Description: descriptions["resource_name_prefix"],
+			},
+			"resource_name_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NCLOUD_RESOURCE_NAME_SUFFIX", ""),
+				Description: descriptions["resource_name_suffix"],
+			},
+			"features": featuresSchema(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"ncloud_regions":               dataSourceNcloudRegions(),
-			"ncloud_zones":                 dataSourceNcloudZones(),
-			"ncloud_server_image":          dataSourceNcloudServerImage(),
-			"ncloud_server_images":         dataSourceNcloudServerImages(),
-			"ncloud_member_server_image":   dataSourceNcloudMemberServerImage(),
-			"ncloud_member_server_images":  dataSourceNcloudMemberServerImages(),
-			"ncloud_server_product":        dataSourceNcloudServerProduct(),
-			"ncloud_server_products":       dataSourceNcloudServerProducts(),
-			"ncloud_port_forwarding_rule":  dataSourceNcloudPortForwardingRule(),
-			"ncloud_port_forwarding_rules": dataSourceNcloudPortForwardingRules(),
-			"ncloud_nas_volume":            dataSourceNcloudNasVolume(),
-			"ncloud_nas_volumes":           dataSourceNcloudNasVolumes(),
-			"ncloud_access_control_group":  dataSourceNcloudAccessControlGroup(),
-			"ncloud_access_control_groups": dataSourceNcloudAccessControlGroups(),
-			"ncloud_access_control_rule":   dataSourceNcloudAccessControlRule(),
-			"ncloud_access_control_rules":  dataSourceNcloudAccessControlRules(),
-			"ncloud_root_password":         dataSourceNcloudRootPassword(),
-			"ncloud_public_ip":             dataSourceNcloudPublicIp(),
+			"ncloud_regions":                 dataSourceNcloudRegions(),
+			"ncloud_zones":                   dataSourceNcloudZones(),
+			"ncloud_server_image":            dataSourceNcloudServerImage(),
+			"ncloud_server_images":           dataSourceNcloudServerImages(),
+			"ncloud_member_server_image":     dataSourceNcloudMemberServerImage(),
+			"ncloud_member_server_images":    dataSourceNcloudMemberServerImages(),
+			"ncloud_server_product":          dataSourceNcloudServerProduct(),
+			"ncloud_server_products":         dataSourceNcloudServerProducts(),
+			"ncloud_port_forwarding_rule":    dataSourceNcloudPortForwardingRule(),
+			"ncloud_port_forwarding_rules":   dataSourceNcloudPortForwardingRules(),
+			"ncloud_block_storage_snapshots": dataSourceNcloudBlockStorageSnapshots(),
+			"ncloud_block_storages":          dataSourceNcloudBlockStorages(),
+			"ncloud_network_interfaces":      dataSourceNcloudNetworkInterfaces(),
+			"ncloud_nas_volume":              dataSourceNcloudNasVolume(),
+			"ncloud_nas_volumes":             dataSourceNcloudNasVolumes(),
+			"ncloud_access_control_group":    dataSourceNcloudAccessControlGroup(),
+			"ncloud_access_control_groups":   dataSourceNcloudAccessControlGroups(),
+			"ncloud_access_control_rule":     dataSourceNcloudAccessControlRule(),
+			"ncloud_access_control_rules":    dataSourceNcloudAccessControlRules(),
+			"ncloud_root_password":           dataSourceNcloudRootPassword(),
+			"ncloud_public_ip":               dataSourceNcloudPublicIp(),
+			"ncloud_public_ips":              dataSourceNcloudPublicIps(),
+			"ncloud_common_codes":            dataSourceNcloudCommonCodes(),
+			"ncloud_environment_summary":     dataSourceNcloudEnvironmentSummary(),
+			"ncloud_server":                  dataSourceNcloudServer(),
+			"ncloud_server_instances":        dataSourceNcloudServerInstances(),
+			"ncloud_service_quotas":          dataSourceNcloudServiceQuotas(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"ncloud_server":                        resourceNcloudServer(),
 			"ncloud_block_storage":                 resourceNcloudBlockStorage(),
+			"ncloud_block_storage_attachment":      resourceNcloudBlockStorageAttachment(),
 			"ncloud_block_storage_snapshot":        resourceNcloudBlockStorageSnapshot(),
 			"ncloud_public_ip":                     resourceNcloudPublicIpInstance(),
+			"ncloud_public_ip_association":         resourceNcloudPublicIpAssociation(),
 			"ncloud_login_key":                     resourceNcloudLoginKey(),
 			"ncloud_nas_volume":                    resourceNcloudNasVolume(),
 			"ncloud_port_forwarding_rule":          resourceNcloudPortForwadingRule(),
 			"ncloud_load_balancer":                 resourceNcloudLoadBalancer(),
 			"ncloud_load_balancer_ssl_certificate": resourceNcloudLoadBalancerSSLCertificate(),
+			"ncloud_member_server_image":           resourceNcloudMemberServerImage(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -66,8 +110,26 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		AccessKey: d.Get("access_key").(string),
-		SecretKey: d.Get("secret_key").(string),
+		AccessKey:           d.Get("access_key").(string),
+		SecretKey:           d.Get("secret_key").(string),
+		EnableHTTP2:         d.Get("enable_http2").(bool),
+		PreflightValidation: d.Get("preflight_validation").(bool),
+		ResourceNamePrefix:  d.Get("resource_name_prefix").(string),
+		ResourceNameSuffix:  d.Get("resource_name_suffix").(string),
+		Features:            expandFeatures(d.Get("features").([]interface{})),
+	}
+
+	if command, ok := d.GetOk("credential_process"); ok && (config.AccessKey == "" || config.SecretKey == "") {
+		output, err := getCredentialProcessOutput(command.(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AccessKey = output.AccessKey
+		config.SecretKey = output.SecretKey
+	}
+
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("access_key and secret_key are required. set them in the provider block, with NCLOUD_ACCESS_KEY and NCLOUD_SECRET_KEY or through credential_process")
 	}
 
 	if region, ok := d.GetOk("region"); ok && os.Getenv("NCLOUD_REGION") == "" {
@@ -85,8 +147,14 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"access_key": "Access key of ncloud",
-		"secret_key": "Secret key of ncloud",
-		"region":     "Region of ncloud",
+		"access_key":           "Access key of ncloud",
+		"secret_key":           "Secret key of ncloud",
+		"credential_process":   "Command printing the access key and secret key of ncloud as JSON",
+		"region":               "Region of ncloud",
+		"enable_http2":         "Negotiate HTTP/2 with the ncloud API gateway",
+		"preflight_validation": "Check resources to create against the API during plan, e.g. server name and product availability",
+		"resource_name_prefix": "Prefix added to the names of the servers, load balancers, block storages and snapshots created",
+		"resource_name_suffix": "Suffix added to the names of the servers, load balancers, block storages and snapshots created",
+		"features":             "Provider level opt-in behaviors",
 	}
 }
@@ -0,0 +1,62 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// referenceLookupFunc reports whether the object identified by id is visible to the API yet.
+type referenceLookupFunc func(client *NcloudAPIClient, id string) (bool, error)
+
+// waitForReferences retries lookup for every id until the API returns it.
+// Objects created earlier in the same apply (ACGs, servers, login keys...) may not be visible to other APIs immediately,
+// so every resource consuming such an id waits for it here before sending its own create request.
+func waitForReferences(client *NcloudAPIClient, kind string, ids []*string, lookup referenceLookupFunc) error {
+	for _, id := range ncloud.StringListValue(ids) {
+		if id == "" {
+			continue
+		}
+		err := resource.Retry(DefaultReferenceTimeout, func() *resource.RetryError {
+			found, err := lookup(client, id)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if !found {
+				log.Printf("[DEBUG] %s [%s] is not visible yet", kind, id)
+				return resource.RetryableError(fmt.Errorf("%s [%s] not found", kind, id))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lookupServerInstance(client *NcloudAPIClient, id string) (bool, error) {
+	instance, err := getServerInstance(client, id)
+	return instance != nil, err
+}
+
+func lookupAccessControlGroup(client *NcloudAPIClient, id string) (bool, error) {
+	resp, err := getAccessControlGroupList(client, &server.GetAccessControlGroupListRequest{
+		AccessControlGroupConfigurationNoList: []*string{ncloud.String(id)},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.AccessControlGroupList) > 0, nil
+}
+
+func lookupLoginKey(client *NcloudAPIClient, id string) (bool, error) {
+	resp, err := getLoginKeyList(client, ncloud.String(id))
+	if err != nil {
+		return false, err
+	}
+	return len(resp.LoginKeyList) > 0, nil
+}
@@ -0,0 +1,36 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+func TestWaitForReferences(t *testing.T) {
+	calls := map[string]int{}
+	lookup := func(client *NcloudAPIClient, id string) (bool, error) {
+		calls[id]++
+		return calls[id] > 1, nil
+	}
+
+	if err := waitForReferences(nil, "Test", ncloud.StringList([]string{"1", "", "2"}), lookup); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls["1"] != 2 || calls["2"] != 2 {
+		t.Fatalf("expected two lookups per id, got %v", calls)
+	}
+	if _, ok := calls[""]; ok {
+		t.Fatalf("expected empty id to be skipped")
+	}
+}
+
+func TestWaitForReferences_error(t *testing.T) {
+	lookup := func(client *NcloudAPIClient, id string) (bool, error) {
+		return false, fmt.Errorf("lookup failed")
+	}
+
+	if err := waitForReferences(nil, "Test", ncloud.StringList([]string{"1"}), lookup); err == nil {
+		t.Fatalf("expected lookup error to be returned")
+	}
+}
@@ -0,0 +1,73 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ignoreResourceNameAffixesSchema is the per resource opt-out of the provider `resource_name_prefix` and `resource_name_suffix`.
+func ignoreResourceNameAffixesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Create the resource with its name as given, without the provider `resource_name_prefix` and `resource_name_suffix`. default: false",
+	}
+}
+
+// addResourceNameAffixes returns the name to create the resource with: the configured name
+// between the provider `resource_name_prefix` and `resource_name_suffix`.
+func addResourceNameAffixes(client *NcloudAPIClient, d *schema.ResourceData, name string) string {
+	if name == "" || d.Get("ignore_resource_name_affixes").(bool) {
+		return name
+	}
+	return client.resourceNamePrefix + name + client.resourceNameSuffix
+}
+
+// maxResourceNameLength is the longest name the APIs accept for the resources with name affixes.
+const maxResourceNameLength = 30
+
+// validateResourceNameLengthForDiff checks at plan time that the name the resource is created with fits in maxResourceNameLength:
+// the configured name between the provider affixes, plus extra characters appended when it is created.
+func validateResourceNameLengthForDiff(diff *schema.ResourceDiff, meta interface{}, key string, extra int) error {
+	client, ok := meta.(*NcloudAPIClient)
+	if !ok {
+		return nil
+	}
+	name, ok := knownString(diff, key)
+	if !ok {
+		return nil
+	}
+	return validateResourceNameLength(client, key, name, diff.Get("ignore_resource_name_affixes").(bool), extra)
+}
+
+func validateResourceNameLength(client *NcloudAPIClient, key string, name string, ignoreAffixes bool, extra int) error {
+	length := len(name) + extra
+	if !ignoreAffixes {
+		length += len(client.resourceNamePrefix) + len(client.resourceNameSuffix)
+	}
+	if length > maxResourceNameLength {
+		return fmt.Errorf("%s %q is %d characters long with the provider resource_name_prefix %q and resource_name_suffix %q, "+
+			"more than the %d characters allowed. shorten it, or set ignore_resource_name_affixes", key, name, length, client.resourceNamePrefix, client.resourceNameSuffix, maxResourceNameLength)
+	}
+	return nil
+}
+
+// trimResourceNameAffixes removes the affixes added by addResourceNameAffixes from a name read from the API,
+// so the configured name is kept in the state.
+func trimResourceNameAffixes(client *NcloudAPIClient, d *schema.ResourceData, name string) string {
+	if d.Get("ignore_resource_name_affixes").(bool) {
+		return name
+	}
+	return trimNameAffixes(name, client.resourceNamePrefix, client.resourceNameSuffix)
+}
+
+func trimNameAffixes(name, prefix, suffix string) string {
+	if len(name) <= len(prefix)+len(suffix) || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return name
+	}
+	return name[len(prefix) : len(name)-len(suffix)]
+}
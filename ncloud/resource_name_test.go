@@ -0,0 +1,51 @@
+package ncloud
+
+import (
+	"testing"
+)
+
+func TestTrimNameAffixes(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefix   string
+		suffix   string
+		expected string
+	}{
+		{"dev-web-blue", "dev-", "-blue", "web"},
+		{"dev-web", "dev-", "", "web"},
+		{"web-blue", "", "-blue", "web"},
+		{"web", "dev-", "", "web"},
+		{"dev-", "dev-", "", "dev-"},
+		{"prod-web", "dev-", "", "prod-web"},
+	}
+
+	for _, tc := range cases {
+		if actual := trimNameAffixes(tc.name, tc.prefix, tc.suffix); actual != tc.expected {
+			t.Fatalf("expected trimNameAffixes(%q, %q, %q) to be %q, but was %q", tc.name, tc.prefix, tc.suffix, tc.expected, actual)
+		}
+	}
+}
+
+func TestValidateResourceNameLength(t *testing.T) {
+	client := &NcloudAPIClient{resourceNamePrefix: "dev-", resourceNameSuffix: "-blue"}
+	cases := []struct {
+		name          string
+		ignoreAffixes bool
+		extra         int
+		expectError   bool
+	}{
+		{"web-01234567890123456", false, 0, false},
+		{"web-012345678901234567", false, 0, true},
+		{"web-012345678901234567", true, 0, false},
+		{"web-012345678901", false, 5, false},
+		{"web-0123456789012", false, 5, true},
+		{"web-0123456789012", true, 5, false},
+	}
+
+	for _, tc := range cases {
+		err := validateResourceNameLength(client, "server_name", tc.name, tc.ignoreAffixes, tc.extra)
+		if tc.expectError != (err != nil) {
+			t.Fatalf("expected error %t for %q (ignore affixes %t, extra %d), but was %v", tc.expectError, tc.name, tc.ignoreAffixes, tc.extra, err)
+		}
+	}
+}
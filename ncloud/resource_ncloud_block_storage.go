@@ -2,12 +2,14 @@ package ncloud
 
 import (
 	"fmt"
-	"time"
-
 	"log"
+	"sync"
+	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -21,6 +23,9 @@ func resourceNcloudBlockStorage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceNcloudBlockStorageCustomizeDiff,
+		SchemaVersion: 1,
+		MigrateState:  commonCodeMigrateState("block_storage_type", "block_storage_instance_status", "block_storage_instance_operation", "disk_type", "disk_detail_type"),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -31,20 +36,22 @@ func resourceNcloudBlockStorage() *schema.Resource {
 			"server_instance_no": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Server instance number to attach. Required value. The server instance number can be obtained through the getServerInstanceList action.",
+				Description: "Server instance number to attach. Required value. The server instance number can be obtained through the getServerInstanceList action. Changing it moves the block storage to the new server.",
 			},
 			"block_storage_size_gb": {
 				// note : value of block_storage_size is different from the parameter and response value.
 				// 	 change the parameter name to block_storage_size_gb.
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "Enter the block storage size to be created. You can enter in GB units, and you can only enter up to 1000 GB.",
+				Description: "Enter the block storage size to be created. You can enter in GB units, and you can only enter up to 1000 GB. It cannot be changed after the block storage is created.",
 			},
 			"block_storage_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Block storage name. default: Assigned by Ncloud",
 			},
+			"ignore_resource_name_affixes": ignoreResourceNameAffixesSchema(),
 			"block_storage_description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -53,6 +60,7 @@ func resourceNcloudBlockStorage() *schema.Resource {
 			"disk_detail_type_code": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "You can choose a disk detail type code of HDD and SSD. default: HDD",
 			},
 
@@ -69,7 +77,7 @@ func resourceNcloudBlockStorage() *schema.Resource {
 				Computed: true,
 			},
 			"block_storage_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -82,12 +90,12 @@ func resourceNcloudBlockStorage() *schema.Resource {
 				Computed: true,
 			},
 			"block_storage_instance_status": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"block_storage_instance_operation": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -100,12 +108,12 @@ func resourceNcloudBlockStorage() *schema.Resource {
 				Computed: true,
 			},
 			"disk_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"disk_detail_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -116,7 +124,10 @@ func resourceNcloudBlockStorage() *schema.Resource {
 func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
-	reqParams := buildRequestBlockStorageInstance(d)
+	reqParams := buildRequestBlockStorageInstance(client, d)
+	if err := waitForReferences(client, "ServerInstance", []*string{reqParams.ServerInstanceNo}, lookupServerInstance); err != nil {
+		return err
+	}
 
 	logCommonRequest("CreateBlockStorageInstance", reqParams)
 
@@ -147,7 +158,7 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("block_storage_instance_no", storage.BlockStorageInstanceNo)
 		d.Set("server_instance_no", storage.ServerInstanceNo)
 		d.Set("block_storage_size", storage.BlockStorageSize)
-		d.Set("block_storage_name", storage.BlockStorageName)
+		d.Set("block_storage_name", trimResourceNameAffixes(client, d, ncloud.StringValue(storage.BlockStorageName)))
 		d.Set("server_name", storage.ServerName)
 		d.Set("device_name", storage.DeviceName)
 		d.Set("block_storage_product_code", storage.BlockStorageProductCode)
@@ -155,19 +166,19 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("create_date", storage.CreateDate)
 		d.Set("block_storage_description", storage.BlockStorageInstanceDescription)
 
-		if err := d.Set("block_storage_type", flattenCommonCode(storage.BlockStorageType)); err != nil {
+		if err := d.Set("block_storage_type", structure.FlattenCommonCodeList(storage.BlockStorageType)); err != nil {
 			return err
 		}
-		if err := d.Set("block_storage_instance_status", flattenCommonCode(storage.BlockStorageInstanceStatus)); err != nil {
+		if err := d.Set("block_storage_instance_status", structure.FlattenCommonCodeList(storage.BlockStorageInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("block_storage_instance_operation", flattenCommonCode(storage.BlockStorageInstanceOperation)); err != nil {
+		if err := d.Set("block_storage_instance_operation", structure.FlattenCommonCodeList(storage.BlockStorageInstanceOperation)); err != nil {
 			return err
 		}
-		if err := d.Set("disk_type", flattenCommonCode(storage.DiskType)); err != nil {
+		if err := d.Set("disk_type", structure.FlattenCommonCodeList(storage.DiskType)); err != nil {
 			return err
 		}
-		if err := d.Set("disk_detail_type", flattenCommonCode(storage.DiskDetailType)); err != nil {
+		if err := d.Set("disk_detail_type", structure.FlattenCommonCodeList(storage.DiskDetailType)); err != nil {
 			return err
 		}
 	}
@@ -190,15 +201,72 @@ func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceNcloudBlockStorageCustomizeDiff checks the name length and rejects a size change at plan time.
+// The block storage API cannot resize a block storage, and replacing it would destroy its data.
+func resourceNcloudBlockStorageCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateResourceNameLengthForDiff(diff, meta, "block_storage_name", 0); err != nil {
+		return err
+	}
+	if diff.Id() != "" && diff.HasChange("block_storage_size_gb") && !diff.HasChange("disk_detail_type_code") {
+		o, n := diff.GetChange("block_storage_size_gb")
+		return fmt.Errorf("changing block_storage_size_gb of block storage instance [%s] from %v to %v is not supported by the ncloud block storage API. "+
+			"revert the change, or taint the resource to recreate the block storage and lose its data", diff.Id(), o, n)
+	}
+	return nil
+}
+
 func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	for _, key := range []string{"block_storage_name", "block_storage_description"} {
+		if d.HasChange(key) {
+			// Keep the prior state so the change is planned again on the next run.
+			d.Partial(true)
+			o, n := d.GetChange(key)
+			return fmt.Errorf("changing %s of block storage instance [%s] from %v to %v is not supported by the ncloud block storage API. "+
+				"revert the change, or taint the resource to recreate the block storage", key, d.Id(), o, n)
+		}
+	}
+
+	if d.HasChange("server_instance_no") {
+		serverInstanceNo := d.Get("server_instance_no").(string)
+		if err := waitForReferences(client, "ServerInstance", []*string{ncloud.String(serverInstanceNo)}, lookupServerInstance); err != nil {
+			return err
+		}
+		// Keep the prior server in the state until the block storage is attached to the new one,
+		// so a failed move is planned again on the next run.
+		d.Partial(true)
+		instance, err := getBlockStorageInstance(client, d.Id())
+		if err != nil {
+			return err
+		}
+		var attachedTo string
+		if instance != nil {
+			attachedTo = ncloud.StringValue(instance.ServerInstanceNo)
+		}
+		// A previous failed move may have detached the block storage already.
+		if attachedTo != "" && attachedTo != serverInstanceNo {
+			if err := detachBlockStorage(d, client, []string{d.Id()}); err != nil {
+				return err
+			}
+		}
+		if attachedTo != serverInstanceNo {
+			if err := attachBlockStorage(client, d.Id(), serverInstanceNo, d); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("server_instance_no")
+		d.Partial(false)
+	}
+
 	return resourceNcloudBlockStorageRead(d, meta)
 }
 
-func buildRequestBlockStorageInstance(d *schema.ResourceData) *server.CreateBlockStorageInstanceRequest {
+func buildRequestBlockStorageInstance(client *NcloudAPIClient, d *schema.ResourceData) *server.CreateBlockStorageInstanceRequest {
 	return &server.CreateBlockStorageInstanceRequest{
 		ServerInstanceNo:        ncloud.String(d.Get("server_instance_no").(string)),
 		BlockStorageSize:        ncloud.Int64(int64(d.Get("block_storage_size_gb").(int))),
-		BlockStorageName:        ncloud.String(d.Get("block_storage_name").(string)),
+		BlockStorageName:        ncloud.String(addResourceNameAffixes(client, d, d.Get("block_storage_name").(string))),
 		BlockStorageDescription: ncloud.String(d.Get("block_storage_description").(string)),
 		DiskDetailTypeCode:      ncloud.String(d.Get("disk_detail_type_code").(string)),
 	}
@@ -280,39 +348,123 @@ func deleteBlockStorageByServerInstanceNo(client *NcloudAPIClient, serverInstanc
 	return deleteBlockStorage(client, ids)
 }
 
+// maxParallelBlockStorageDetach bounds the detach requests sent at once, to stay clear of the API rate limits.
+const maxParallelBlockStorageDetach = 4
+
+// detachBlockStorage detaches the block storages concurrently, at most maxParallelBlockStorageDetach at a time,
+// then waits for all of them to be detached.
 func detachBlockStorage(d *schema.ResourceData, client *NcloudAPIClient, blockStorageIds []string) error {
+	var mu sync.Mutex
+	var detached []string
+	detachErr := forEachParallel(blockStorageIds, maxParallelBlockStorageDetach, func(blockStorageId string) error {
+		if err := requestDetachBlockStorage(d, client, blockStorageId); err != nil {
+			return err
+		}
+		mu.Lock()
+		detached = append(detached, blockStorageId)
+		mu.Unlock()
+		return nil
+	})
+
+	// Wait for the block storages being detached even when others failed, so none is left in the middle of a detach.
+	if err := waitForBlockStorageInstances(client, detached, "CREAT", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return multierror.Append(detachErr, err)
+	}
+	return detachErr
+}
+
+func requestDetachBlockStorage(d *schema.ResourceData, client *NcloudAPIClient, blockStorageId string) error {
+	reqParams := &server.DetachBlockStorageInstancesRequest{
+		BlockStorageInstanceNoList: []*string{ncloud.String(blockStorageId)},
+	}
+
 	var resp *server.DetachBlockStorageInstancesResponse
-	for _, blockStorageId := range blockStorageIds {
-		reqParams := &server.DetachBlockStorageInstancesRequest{
-			BlockStorageInstanceNoList: []*string{ncloud.String(blockStorageId)},
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		var err error
+
+		logCommonRequest("DetachBlockStorageInstances", reqParams)
+
+		resp, err = client.server.V2Api.DetachBlockStorageInstances(reqParams)
+		if err == nil && resp == nil {
+			return resource.NonRetryableError(err)
+		}
+		if resp != nil && isRetryableErr(GetCommonResponse(resp), []string{ApiErrorUnknown, ApiErrorDetachingMountedStorage}) {
+			logErrorResponse("retry DetachBlockStorageInstances", err, reqParams)
+			return resource.RetryableError(err)
 		}
-		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-			var err error
+		return resource.NonRetryableError(err)
+	})
 
-			logCommonRequest("DetachBlockStorageInstances", reqParams)
+	if err != nil {
+		logErrorResponse("DetachBlockStorageInstances", err, reqParams)
+		return err
+	}
+	logCommonResponse("DetachBlockStorageInstances", GetCommonResponse(resp))
+	return nil
+}
 
-			resp, err = client.server.V2Api.DetachBlockStorageInstances(reqParams)
-			if err == nil && resp == nil {
-				return resource.NonRetryableError(err)
-			}
-			if resp != nil && isRetryableErr(GetCommonResponse(resp), []string{ApiErrorUnknown, ApiErrorDetachingMountedStorage}) {
-				logErrorResponse("retry DetachBlockStorageInstances", err, reqParams)
-				return resource.RetryableError(err)
+// forEachParallel calls f for each id, running at most limit calls at a time, and returns the errors of all the calls.
+func forEachParallel(ids []string, limit int, f func(id string) error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+	sem := make(chan struct{}, limit)
+
+	for _, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := f(id); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
 			}
-			return resource.NonRetryableError(err)
-		})
+		}(id)
+	}
+	wg.Wait()
 
+	return errs.ErrorOrNil()
+}
+
+// waitForBlockStorageInstances polls the block storages with a single list request per round
+// until all of them have the status. A block storage not found any more counts as done.
+func waitForBlockStorageInstances(client *NcloudAPIClient, ids []string, status string, timeout time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	pending := ids
+	return resource.Retry(timeout, func() *resource.RetryError {
+		reqParams := &server.GetBlockStorageInstanceListRequest{
+			BlockStorageInstanceNoList: ncloud.StringList(pending),
+		}
+
+		logCommonRequest("GetBlockStorageInstanceList", reqParams)
+
+		resp, err := client.server.V2Api.GetBlockStorageInstanceList(reqParams)
 		if err != nil {
-			logErrorResponse("DetachBlockStorageInstances", err, reqParams)
-			return err
+			logErrorResponse("GetBlockStorageInstanceList", err, reqParams)
+			return resource.NonRetryableError(err)
 		}
-		logCommonResponse("DetachBlockStorageInstances", GetCommonResponse(resp))
+		logCommonResponse("GetBlockStorageInstanceList", GetCommonResponse(resp))
 
-		if err := waitForBlockStorageInstance(client, blockStorageId, "CREAT"); err != nil {
-			return err
+		var stillPending []string
+		for _, instance := range resp.BlockStorageInstanceList {
+			if instance.BlockStorageInstanceStatus == nil || ncloud.StringValue(instance.BlockStorageInstanceStatus.Code) != status {
+				stillPending = append(stillPending, ncloud.StringValue(instance.BlockStorageInstanceNo))
+			}
 		}
-	}
-	return nil
+		if len(stillPending) > 0 {
+			pending = stillPending
+			log.Printf("[DEBUG] Wait block storage instances %v to be [%s]", pending, status)
+			return resource.RetryableError(fmt.Errorf("block storage instances %v are not %s yet", pending, status))
+		}
+		return nil
+	})
 }
 
 func detachBlockStorageByServerInstanceNo(d *schema.ResourceData, client *NcloudAPIClient, serverInstanceNo string) error {
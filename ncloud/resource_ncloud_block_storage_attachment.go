@@ -0,0 +1,158 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudBlockStorageAttachmentCreate,
+		Read:   resourceNcloudBlockStorageAttachmentRead,
+		Delete: resourceNcloudBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Block storage instance No. to attach",
+			},
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Server instance No. to attach the block storage to",
+			},
+
+			"device_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Device name of the block storage on the server, assigned by ncloud",
+			},
+		},
+	}
+}
+
+func resourceNcloudBlockStorageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	blockStorageInstanceNo := d.Get("block_storage_instance_no").(string)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if err := waitForReferences(client, "ServerInstance", []*string{ncloud.String(serverInstanceNo)}, lookupServerInstance); err != nil {
+		return err
+	}
+
+	storage, err := getBlockStorageInstance(client, blockStorageInstanceNo)
+	if err != nil {
+		return err
+	}
+	if storage == nil {
+		return fmt.Errorf("block storage instance [%s] not found", blockStorageInstanceNo)
+	}
+
+	// A block storage moved to a new server is detached from the previous one first.
+	if attached := ncloud.StringValue(storage.ServerInstanceNo); attached != "" && attached != serverInstanceNo {
+		log.Printf("[INFO] Detach block storage instance [%s] from server instance [%s]", blockStorageInstanceNo, attached)
+		if err := detachBlockStorage(d, client, []string{blockStorageInstanceNo}); err != nil {
+			return err
+		}
+	}
+
+	if ncloud.StringValue(storage.ServerInstanceNo) != serverInstanceNo {
+		if err := attachBlockStorage(client, blockStorageInstanceNo, serverInstanceNo, d); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(blockStorageInstanceNo)
+
+	return resourceNcloudBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	storage, err := getBlockStorageInstance(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if storage == nil || ncloud.StringValue(storage.ServerInstanceNo) == "" ||
+		(serverInstanceNo != "" && ncloud.StringValue(storage.ServerInstanceNo) != serverInstanceNo) {
+		log.Printf("[WARN] Block storage instance [%s] is not attached to server instance [%s], removing attachment from state", d.Id(), serverInstanceNo)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_instance_no", storage.BlockStorageInstanceNo)
+	d.Set("server_instance_no", storage.ServerInstanceNo)
+	d.Set("device_name", storage.DeviceName)
+
+	return nil
+}
+
+func resourceNcloudBlockStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	storage, err := getBlockStorageInstance(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The block storage may already be attached to the server replacing this one.
+	if storage != nil && ncloud.StringValue(storage.ServerInstanceNo) == d.Get("server_instance_no").(string) {
+		if err := detachBlockStorage(d, client, []string{d.Id()}); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func attachBlockStorage(client *NcloudAPIClient, blockStorageInstanceNo string, serverInstanceNo string, d *schema.ResourceData) error {
+	reqParams := &server.AttachBlockStorageInstanceRequest{
+		BlockStorageInstanceNo: ncloud.String(blockStorageInstanceNo),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+	}
+
+	var resp *server.AttachBlockStorageInstanceResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+
+		logCommonRequest("AttachBlockStorageInstance", reqParams)
+
+		resp, err = client.server.V2Api.AttachBlockStorageInstance(reqParams)
+		if err == nil && resp == nil {
+			return resource.NonRetryableError(err)
+		}
+		if resp != nil && isRetryableErr(GetCommonResponse(resp), []string{ApiErrorUnknown, ApiErrorObjectInOperation}) {
+			logErrorResponse("retry AttachBlockStorageInstance", err, reqParams)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+
+	if err != nil {
+		logErrorResponse("AttachBlockStorageInstance", err, reqParams)
+		return err
+	}
+	logCommonResponse("AttachBlockStorageInstance", GetCommonResponse(resp))
+
+	return waitForBlockStorageInstance(client, blockStorageInstanceNo, "ATTAC")
+}
@@ -0,0 +1,123 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceNcloudBlockStorageAttachmentBasic(t *testing.T) {
+	var storageInstance server.BlockStorageInstance
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The block storage is created on the first server and moved to the second one.
+				Config: testAccBlockStorageAttachmentConfig(testServerName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExists("ncloud_block_storage.storage", &storageInstance),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_block_storage_attachment.attachment", "server_instance_no",
+						"ncloud_server.second", "id"),
+					resource.TestCheckResourceAttrSet("ncloud_block_storage_attachment.attachment", "device_name"),
+				),
+			},
+			{
+				// The block storage moves back to the first server on the next apply, without being recreated.
+				Config: testAccBlockStorageAttachmentConfig(testServerName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ncloud_block_storage_attachment.attachment", "server_instance_no",
+						"ncloud_server.first", "id"),
+					testAccCheckBlockStorageNotRecreated("ncloud_block_storage.storage", &storageInstance),
+				),
+			},
+			{
+				ResourceName:      "ncloud_block_storage_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageNotRecreated(n string, before *server.BlockStorageInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID != ncloud.StringValue(before.BlockStorageInstanceNo) {
+			return fmt.Errorf("block storage instance was recreated: [%s] is now [%s]", ncloud.StringValue(before.BlockStorageInstanceNo), rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*NcloudAPIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_block_storage_attachment" {
+			continue
+		}
+
+		storage, err := getBlockStorageInstance(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if storage != nil && ncloud.StringValue(storage.ServerInstanceNo) == rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("block storage instance [%s] is still attached to server instance [%s]", rs.Primary.ID, rs.Primary.Attributes["server_instance_no"])
+		}
+	}
+
+	return nil
+}
+
+func testAccBlockStorageAttachmentConfig(testServerName string, attachedServer string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%[1]s-key"
+}
+
+resource "ncloud_server" "first" {
+	"server_name" = "%[1]s-1"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_server" "second" {
+	"server_name" = "%[1]s-2"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_block_storage" "storage" {
+	"server_instance_no" = "${ncloud_server.first.id}"
+	"block_storage_size_gb" = "10"
+
+	lifecycle {
+		ignore_changes = ["server_instance_no"]
+	}
+}
+
+resource "ncloud_block_storage_attachment" "attachment" {
+	"block_storage_instance_no" = "${ncloud_block_storage.storage.id}"
+	"server_instance_no" = "${ncloud_server.%[2]s.id}"
+}
+`, testServerName, attachedServer)
+}
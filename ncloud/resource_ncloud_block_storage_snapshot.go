@@ -8,6 +8,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -20,6 +21,11 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(diff *schema.ResourceDiff, meta interface{}) error {
+			return validateResourceNameLengthForDiff(diff, meta, "block_storage_snapshot_name", 0)
+		},
+		SchemaVersion: 1,
+		MigrateState:  commonCodeMigrateState("block_storage_snapshot_instance_status", "block_storage_snapshot_instance_operation"),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -39,6 +45,7 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 				Computed:    true,
 				Description: "Block storage snapshot name to create. default : Ncloud assigns default values.",
 			},
+			"ignore_resource_name_affixes": ignoreResourceNameAffixesSchema(),
 			"block_storage_snapshot_description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -67,14 +74,14 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 				Description: "Original Block Storage Name",
 			},
 			"block_storage_snapshot_instance_status": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Block Storage Snapshot Instance Status",
 			},
 			"block_storage_snapshot_instance_operation": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Block Storage Snapshot Instance Operation",
@@ -112,7 +119,7 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
-	reqParams := buildRequestBlockStorageSnapshotInstance(d)
+	reqParams := buildRequestBlockStorageSnapshotInstance(client, d)
 	logCommonRequest("CreateBlockStorageSnapshotInstance", reqParams)
 
 	resp, err := client.server.V2Api.CreateBlockStorageSnapshotInstance(reqParams)
@@ -140,7 +147,7 @@ func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interfa
 
 	if snapshot != nil {
 		d.Set("block_storage_snapshot_instance_no", snapshot.BlockStorageSnapshotInstanceNo)
-		d.Set("block_storage_snapshot_name", snapshot.BlockStorageSnapshotName)
+		d.Set("block_storage_snapshot_name", trimResourceNameAffixes(client, d, ncloud.StringValue(snapshot.BlockStorageSnapshotName)))
 		d.Set("block_storage_snapshot_volume_size", snapshot.BlockStorageSnapshotVolumeSize)
 		d.Set("original_block_storage_instance_no", snapshot.OriginalBlockStorageInstanceNo)
 		d.Set("original_block_storage_name", snapshot.OriginalBlockStorageName)
@@ -149,10 +156,10 @@ func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interfa
 		d.Set("server_image_product_code", snapshot.ServerImageProductCode)
 		d.Set("os_information", snapshot.OsInformation)
 
-		if err := d.Set("block_storage_snapshot_instance_status", flattenCommonCode(snapshot.BlockStorageSnapshotInstanceStatus)); err != nil {
+		if err := d.Set("block_storage_snapshot_instance_status", structure.FlattenCommonCodeList(snapshot.BlockStorageSnapshotInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("block_storage_snapshot_instance_operation", flattenCommonCode(snapshot.BlockStorageSnapshotInstanceOperation)); err != nil {
+		if err := d.Set("block_storage_snapshot_instance_operation", structure.FlattenCommonCodeList(snapshot.BlockStorageSnapshotInstanceOperation)); err != nil {
 			return err
 		}
 	}
@@ -174,10 +181,10 @@ func resourceNcloudBlockStorageSnapshotDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func buildRequestBlockStorageSnapshotInstance(d *schema.ResourceData) *server.CreateBlockStorageSnapshotInstanceRequest {
+func buildRequestBlockStorageSnapshotInstance(client *NcloudAPIClient, d *schema.ResourceData) *server.CreateBlockStorageSnapshotInstanceRequest {
 	return &server.CreateBlockStorageSnapshotInstanceRequest{
 		BlockStorageInstanceNo:          ncloud.String(d.Get("block_storage_instance_no").(string)),
-		BlockStorageSnapshotName:        ncloud.String(d.Get("block_storage_snapshot_name").(string)),
+		BlockStorageSnapshotName:        ncloud.String(addResourceNameAffixes(client, d, d.Get("block_storage_snapshot_name").(string))),
 		BlockStorageSnapshotDescription: ncloud.String(d.Get("block_storage_snapshot_description").(string)),
 	}
 }
@@ -2,11 +2,15 @@ package ncloud
 
 import (
 	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
-	"testing"
 )
 
 func TestAccResourceNcloudBlockStorageBasic(t *testing.T) {
@@ -41,7 +45,7 @@ func TestAccResourceNcloudBlockStorageBasic(t *testing.T) {
 						testBlockStorageName),
 					resource.TestCheckResourceAttr(
 						"ncloud_block_storage.storage",
-						"block_storage_instance_status.code",
+						"block_storage_instance_status.0.code",
 						"ATTAC"),
 				),
 			},
@@ -127,3 +131,40 @@ resource "ncloud_block_storage" "storage" {
 }
 `, serverInstanceName, serverInstanceName, blockStorageName)
 }
+
+func TestForEachParallel(t *testing.T) {
+	var mu sync.Mutex
+	var running, maxRunning int
+	var called []string
+
+	err := forEachParallel([]string{"1", "2", "3", "4", "5", "6"}, 2, func(id string) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		called = append(called, id)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if id == "3" || id == "5" {
+			return fmt.Errorf("failed %s", id)
+		}
+		return nil
+	})
+
+	if len(called) != 6 {
+		t.Fatalf("expected 6 calls, but got %v", called)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 calls at a time, but got %d", maxRunning)
+	}
+	if err == nil || !strings.Contains(err.Error(), "failed 3") || !strings.Contains(err.Error(), "failed 5") {
+		t.Fatalf("expected the errors of ids 3 and 5, but got %v", err)
+	}
+}
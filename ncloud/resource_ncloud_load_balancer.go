@@ -7,6 +7,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -19,6 +20,17 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState: commonCodeMigrateState(
+			"load_balancer_algorithm_type",
+			"internet_line_type",
+			"load_balancer_instance_status",
+			"load_balancer_instance_operation",
+			"network_usage_type",
+			"protocol_type",
+		),
+
+		CustomizeDiff: resourceNcloudLoadBalancerCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -33,10 +45,11 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				ValidateFunc: validateStringLengthInRange(3, 30),
 				Description:  "Name of a load balancer to create. Default: Automatically specified by Ncloud.",
 			},
+			"ignore_resource_name_affixes": ignoreResourceNameAffixesSchema(),
 			"load_balancer_algorithm_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateIncludeValues([]string{"RR", "LC", "SIPHS"}),
+				ValidateFunc: validateIncludeValues(commonCodeValues("load_balancer_algorithm_type")),
 				Description:  "Load balancer algorithm type code. The available algorithms are as follows: [ROUND ROBIN (RR) | LEAST_CONNECTION (LC)]. Default: ROUND ROBIN (RR)",
 			},
 			"load_balancer_description": {
@@ -57,16 +70,22 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of server instance numbers to be bound to the load balancer",
 			},
+			"maintenance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Take all servers of `server_instance_no_list` out of service without removing them from the configuration.",
+			},
 			"internet_line_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateIncludeValues([]string{"PUBLC", "GLBL"}),
+				ValidateFunc: validateIncludeValues(commonCodeValues("internet_line_type")),
 				Description:  "Internet line identification code. PUBLC(Public), GLBL(Global). default : PUBLC(Public)",
 			},
 			"network_usage_type_code": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateIncludeValues([]string{"PBLIP", "PRVT"}),
+				ValidateFunc: validateIncludeValues(commonCodeValues("load_balancer_network_usage_type")),
 				Description:  "Network usage identification code. PBLIP(PublicIp), PRVT(PrivateIP). default : PBLIP(PublicIp)",
 			},
 			"region_code": {
@@ -90,7 +109,7 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				Computed: true,
 			},
 			"load_balancer_algorithm_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -103,7 +122,7 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				Computed: true,
 			},
 			"internet_line_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -112,17 +131,17 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				Computed: true,
 			},
 			"load_balancer_instance_status": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"load_balancer_instance_operation": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"network_usage_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -154,6 +173,9 @@ func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	if err := waitForReferences(client, "ServerInstance", reqParams.ServerInstanceNoList, lookupServerInstance); err != nil {
+		return err
+	}
 	logCommonRequest("CreateLoadBalancerInstance", reqParams)
 	resp, err := client.loadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
@@ -181,7 +203,7 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 
 	if lb != nil {
 		d.Set("virtual_ip", lb.VirtualIp)
-		d.Set("load_balancer_name", lb.LoadBalancerName)
+		d.Set("load_balancer_name", trimResourceNameAffixes(client, d, ncloud.StringValue(lb.LoadBalancerName)))
 		d.Set("load_balancer_description", lb.LoadBalancerDescription)
 		d.Set("create_date", lb.CreateDate)
 		d.Set("domain_name", lb.DomainName)
@@ -190,19 +212,19 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("connection_timeout", lb.ConnectionTimeout)
 		d.Set("certificate_name", lb.CertificateName)
 
-		if err := d.Set("load_balancer_algorithm_type", flattenCommonCode(lb.LoadBalancerAlgorithmType)); err != nil {
+		if err := d.Set("load_balancer_algorithm_type", structure.FlattenCommonCodeList(lb.LoadBalancerAlgorithmType)); err != nil {
 			return err
 		}
-		if err := d.Set("internet_line_type", flattenCommonCode(lb.InternetLineType)); err != nil {
+		if err := d.Set("internet_line_type", structure.FlattenCommonCodeList(lb.InternetLineType)); err != nil {
 			return err
 		}
-		if err := d.Set("load_balancer_instance_status", flattenCommonCode(lb.LoadBalancerInstanceStatus)); err != nil {
+		if err := d.Set("load_balancer_instance_status", structure.FlattenCommonCodeList(lb.LoadBalancerInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("load_balancer_instance_operation", flattenCommonCode(lb.LoadBalancerInstanceOperation)); err != nil {
+		if err := d.Set("load_balancer_instance_operation", structure.FlattenCommonCodeList(lb.LoadBalancerInstanceOperation)); err != nil {
 			return err
 		}
-		if err := d.Set("network_usage_type", flattenCommonCode(lb.NetworkUsageType)); err != nil {
+		if err := d.Set("network_usage_type", structure.FlattenCommonCodeList(lb.NetworkUsageType)); err != nil {
 			return err
 		}
 
@@ -233,12 +255,40 @@ func resourceNcloudLoadBalancerDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// loadBalancerDisruptiveChanges are the in-place updates of ncloud_load_balancer that interrupt the traffic.
+var loadBalancerDisruptiveChanges = []disruptiveChange{
+	{
+		Key:    "maintenance",
+		Impact: "deregisters all servers from the load balancer",
+		Applies: func(diff resourceChangeReader) bool {
+			return diff.Get("maintenance").(bool)
+		},
+	},
+	{
+		Key:    "load_balancer_rule_list",
+		Impact: "reconfigures the listeners of the load balancer",
+	},
+}
+
+func resourceNcloudLoadBalancerCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	warnDisruptiveChanges("ncloud_load_balancer", diff, loadBalancerDisruptiveChanges)
+
+	if err := validateResourceNameLengthForDiff(diff, meta, "load_balancer_name", 0); err != nil {
+		return err
+	}
+
+	if client, ok := meta.(*NcloudAPIClient); ok {
+		return checkServiceAvailableForDiff(client, ServiceLoadBalancer, diff)
+	}
+	return nil
+}
+
 func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
 	// Change Load Balanced Server Instances
-	if d.HasChange("server_instance_no_list") {
-		if err := changeLoadBalancedServerInstances(client, d); err != nil {
+	if d.HasChange("server_instance_no_list") || d.HasChange("maintenance") {
+		if err := changeLoadBalancedServerInstances(client, d.Id(), loadBalancedServerInstanceNoList(d)); err != nil {
 			return err
 		}
 	}
@@ -248,7 +298,7 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 		LoadBalancerAlgorithmTypeCode: ncloud.String(d.Get("load_balancer_algorithm_type_code").(string)),
 	}
 
-	if loadBalancerRuleParams, err := expandLoadBalancerRuleParams(d.Get("load_balancer_rule_list").([]interface{})); err == nil {
+	if loadBalancerRuleParams, err := structure.ExpandLoadBalancerRuleParams(d.Get("load_balancer_rule_list").([]interface{})); err == nil {
 		reqParams.LoadBalancerRuleList = loadBalancerRuleParams
 	}
 
@@ -273,10 +323,10 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 	return resourceNcloudLoadBalancerRead(d, meta)
 }
 
-func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.ResourceData) error {
+func changeLoadBalancedServerInstances(client *NcloudAPIClient, loadBalancerInstanceNo string, serverInstanceNoList []*string) error {
 	reqParams := &loadbalancer.ChangeLoadBalancedServerInstancesRequest{
-		LoadBalancerInstanceNo: ncloud.String(d.Id()),
-		ServerInstanceNoList:   expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
+		LoadBalancerInstanceNo: ncloud.String(loadBalancerInstanceNo),
+		ServerInstanceNoList:   serverInstanceNoList,
 	}
 
 	logCommonRequest("ChangeLoadBalancedServerInstances", reqParams)
@@ -288,13 +338,61 @@ func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.Resour
 	}
 	logCommonResponse("ChangeLoadBalancedServerInstances", GetCommonResponse(resp))
 
-	if err := waitForLoadBalancerInstance(client, d.Id(), "USED", DefaultUpdateTimeout); err != nil {
+	if err := waitForLoadBalancerInstance(client, loadBalancerInstanceNo, "USED", DefaultUpdateTimeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// getLoadBalancersOfServer returns the load balancers of the region the server instance is bound to.
+func getLoadBalancersOfServer(client *NcloudAPIClient, regionNo *string, serverInstanceNo string) ([]*loadbalancer.LoadBalancerInstance, error) {
+	reqParams := &loadbalancer.GetLoadBalancerInstanceListRequest{
+		RegionNo: regionNo,
+	}
+	logCommonRequest("GetLoadBalancerInstanceList", reqParams)
+	resp, err := client.loadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(resp))
+
+	return filterLoadBalancersByServerInstanceNo(resp.LoadBalancerInstanceList, serverInstanceNo), nil
+}
+
+func filterLoadBalancersByServerInstanceNo(lbs []*loadbalancer.LoadBalancerInstance, serverInstanceNo string) []*loadbalancer.LoadBalancerInstance {
+	var filtered []*loadbalancer.LoadBalancerInstance
+	for _, lb := range lbs {
+		for _, no := range flattenLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList) {
+			if no == serverInstanceNo {
+				filtered = append(filtered, lb)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// deregisterServerFromLoadBalancer removes the server instance from the servers bound to the load balancer.
+func deregisterServerFromLoadBalancer(client *NcloudAPIClient, lb *loadbalancer.LoadBalancerInstance, serverInstanceNo string) error {
+	serverInstanceNoList := []*string{}
+	for _, no := range flattenLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList) {
+		if no != serverInstanceNo {
+			serverInstanceNoList = append(serverInstanceNoList, ncloud.String(no))
+		}
+	}
+	return changeLoadBalancedServerInstances(client, ncloud.StringValue(lb.LoadBalancerInstanceNo), serverInstanceNoList)
+}
+
+// loadBalancedServerInstanceNoList returns the servers to put in service: none in maintenance, `server_instance_no_list` otherwise.
+func loadBalancedServerInstanceNoList(d *schema.ResourceData) []*string {
+	if d.Get("maintenance").(bool) {
+		return []*string{}
+	}
+	return structure.ExpandStringInterfaceList(d.Get("server_instance_no_list").([]interface{}))
+}
+
 func buildCreateLoadBalancerInstanceParams(client *NcloudAPIClient, d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
 	regionNo, err := parseRegionNoParameter(client, d)
 	if err != nil {
@@ -302,16 +400,16 @@ func buildCreateLoadBalancerInstanceParams(client *NcloudAPIClient, d *schema.Re
 	}
 
 	reqParams := &loadbalancer.CreateLoadBalancerInstanceRequest{
-		LoadBalancerName:              ncloud.String(d.Get("load_balancer_name").(string)),
+		LoadBalancerName:              ncloud.String(addResourceNameAffixes(client, d, d.Get("load_balancer_name").(string))),
 		LoadBalancerAlgorithmTypeCode: ncloud.String(d.Get("load_balancer_algorithm_type_code").(string)),
 		LoadBalancerDescription:       ncloud.String(d.Get("load_balancer_description").(string)),
-		ServerInstanceNoList:          expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
+		ServerInstanceNoList:          loadBalancedServerInstanceNoList(d),
 		InternetLineTypeCode:          StringPtrOrNil(d.GetOk("internet_line_type_code")),
 		NetworkUsageTypeCode:          ncloud.String(d.Get("network_usage_type_code").(string)),
 		RegionNo:                      regionNo,
 	}
 
-	if loadBalancerRuleParams, err := expandLoadBalancerRuleParams(d.Get("load_balancer_rule_list").([]interface{})); err == nil {
+	if loadBalancerRuleParams, err := structure.ExpandLoadBalancerRuleParams(d.Get("load_balancer_rule_list").([]interface{})); err == nil {
 		reqParams.LoadBalancerRuleList = loadBalancerRuleParams
 	}
 
@@ -425,7 +523,7 @@ var loadBalancerRuleSchemaResource = &schema.Resource{
 			Description: "Protocol type code of load balancer rules. The following codes are available. [HTTP | HTTPS | TCP | SSL]",
 		},
 		"protocol_type": {
-			Type:        schema.TypeMap,
+			Type:        schema.TypeList,
 			Computed:    true,
 			Elem:        commonCodeSchemaResource,
 			Description: "",
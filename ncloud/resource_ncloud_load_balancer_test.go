@@ -2,8 +2,10 @@ package ncloud
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -180,3 +182,47 @@ func testAccLoadBalancerChangedConfig(lbName string) string {
 		}
 		`, lbName)
 }
+
+func TestLoadBalancedServerInstanceNoList(t *testing.T) {
+	raw := map[string]interface{}{
+		"server_instance_no_list": []interface{}{"100", "101"},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNcloudLoadBalancer().Schema, raw)
+	if list := ncloud.StringListValue(loadBalancedServerInstanceNoList(d)); !reflect.DeepEqual(list, []string{"100", "101"}) {
+		t.Fatalf("expected servers in service, but was %v", list)
+	}
+
+	raw["maintenance"] = true
+	d = schema.TestResourceDataRaw(t, resourceNcloudLoadBalancer().Schema, raw)
+	if list := loadBalancedServerInstanceNoList(d); len(list) != 0 {
+		t.Fatalf("expected no servers in service in maintenance, but was %v", ncloud.StringListValue(list))
+	}
+}
+
+func TestFilterLoadBalancersByServerInstanceNo(t *testing.T) {
+	lbs := []*loadbalancer.LoadBalancerInstance{
+		{
+			LoadBalancerInstanceNo: ncloud.String("10"),
+			LoadBalancedServerInstanceList: []*loadbalancer.LoadBalancedServerInstance{
+				{ServerInstance: &loadbalancer.ServerInstance{ServerInstanceNo: ncloud.String("100")}},
+				{ServerInstance: &loadbalancer.ServerInstance{ServerInstanceNo: ncloud.String("101")}},
+			},
+		},
+		{
+			LoadBalancerInstanceNo: ncloud.String("11"),
+			LoadBalancedServerInstanceList: []*loadbalancer.LoadBalancedServerInstance{
+				{ServerInstance: &loadbalancer.ServerInstance{ServerInstanceNo: ncloud.String("102")}},
+			},
+		},
+		{
+			LoadBalancerInstanceNo: ncloud.String("12"),
+		},
+	}
+
+	result := filterLoadBalancersByServerInstanceNo(lbs, "101")
+
+	if len(result) != 1 || ncloud.StringValue(result[0].LoadBalancerInstanceNo) != "10" {
+		t.Fatalf("expected load balancer '10' only, but was %v", result)
+	}
+}
@@ -15,7 +15,6 @@ func resourceNcloudLoginKey() *schema.Resource {
 	return &schema.Resource{
 		Read:   resourceNcloudLoginKeyRead,
 		Create: resourceNcloudLoginKeyCreate,
-		Update: resourceNcloudLoginKeyUpdate,
 		Delete: resourceNcloudLoginKeyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -29,9 +28,27 @@ func resourceNcloudLoginKey() *schema.Resource {
 			"key_name": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validateStringLengthInRange(3, 30),
 				Description:  "Key name to generate. If the generated key name exists, an error occurs.",
 			},
+			"public_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSSHPublicKey,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSpace(v.(string))
+				},
+				Description: "Public key in OpenSSH authorized_keys format to register, instead of generating a key pair. `private_key` is then empty.",
+			},
+			"rotate_on_change": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that replace the login key with a new key pair of the same name when they change, e.g. a rotation date",
+			},
 			"private_key": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -67,22 +84,30 @@ func resourceNcloudLoginKeyRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if loginKey != nil {
-		d.Set("fingerprint", loginKey.Fingerprint)
-		d.Set("create_date", loginKey.CreateDate)
+	if loginKey == nil {
+		log.Printf("[WARN] Login key [%s] not found, removing from state", keyName)
+		d.SetId("")
+		return nil
 	}
 
-	return nil
-}
+	d.Set("fingerprint", loginKey.Fingerprint)
+	d.Set("create_date", loginKey.CreateDate)
 
-func resourceNcloudLoginKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudLoginKeyRead(d, meta)
+	return nil
 }
 
 func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
 	keyName := d.Get("key_name").(string)
+	if publicKey, ok := d.GetOk("public_key"); ok {
+		if err := importLoginKey(client, keyName, strings.TrimSpace(publicKey.(string))); err != nil {
+			return err
+		}
+		d.SetId(keyName)
+		return resourceNcloudLoginKeyRead(d, meta)
+	}
+
 	reqParams := &server.CreateLoginKeyRequest{KeyName: ncloud.String(keyName)}
 
 	logCommonRequest("CreateLoginKey", reqParams)
@@ -112,6 +137,24 @@ func resourceNcloudLoginKeyDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func importLoginKey(client *NcloudAPIClient, keyName string, publicKey string) error {
+	reqParams := &server.ImportLoginKeyRequest{
+		KeyName:   ncloud.String(keyName),
+		PublicKey: ncloud.String(publicKey),
+	}
+
+	logCommonRequest("ImportLoginKey", reqParams)
+
+	resp, err := client.server.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		logErrorResponse("ImportLoginKey", err, keyName)
+		return err
+	}
+	logCommonResponse("ImportLoginKey", GetCommonResponse(resp))
+
+	return nil
+}
+
 func getLoginKeyList(client *NcloudAPIClient, keyName *string) (*server.GetLoginKeyListResponse, error) {
 	reqParams := &server.GetLoginKeyListRequest{}
 	if keyName != nil {
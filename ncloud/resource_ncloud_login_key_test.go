@@ -1,12 +1,17 @@
 package ncloud
 
 import (
+	"crypto/rand"
 	"fmt"
+	"strings"
+	"testing"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
-	"testing"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestAccResourceNcloudLoginKeyBasic(t *testing.T) {
@@ -44,6 +49,64 @@ func TestAccResourceNcloudLoginKeyBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudLoginKeyPublicKey(t *testing.T) {
+	var loginKey server.LoginKey
+	testKeyName := getTestPrefix() + "-imported"
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoginKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginKeyPublicKeyConfig(testKeyName, string(ssh.MarshalAuthorizedKey(key))),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoginKeyExists("ncloud_login_key.imported", &loginKey),
+					resource.TestCheckResourceAttr("ncloud_login_key.imported", "private_key", ""),
+					resource.TestCheckResourceAttrSet("ncloud_login_key.imported", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudLoginKeyRotateOnChange(t *testing.T) {
+	var before, after server.LoginKey
+	testKeyName := getTestPrefix() + "-rotated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoginKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginKeyRotateOnChangeConfig(testKeyName, "1"),
+				Check:  testAccCheckLoginKeyExists("ncloud_login_key.rotated", &before),
+			},
+			{
+				Config: testAccLoginKeyRotateOnChangeConfig(testKeyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoginKeyExists("ncloud_login_key.rotated", &after),
+					func(*terraform.State) error {
+						if *before.Fingerprint == *after.Fingerprint {
+							return fmt.Errorf("login key %s was not rotated", testKeyName)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLoginKeyExists(n string, i *server.LoginKey) resource.TestCheckFunc {
 	return testAccCheckLoginKeyExistsWithProvider(n, i, func() *schema.Provider { return testAccProvider })
 }
@@ -109,3 +172,23 @@ resource "ncloud_login_key" "loginkey" {
 }
 `, keyName)
 }
+
+func testAccLoginKeyPublicKeyConfig(keyName string, publicKey string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "imported" {
+	"key_name" = "%s"
+	"public_key" = "%s"
+}
+`, keyName, strings.TrimSpace(publicKey))
+}
+
+func testAccLoginKeyRotateOnChangeConfig(keyName string, rotation string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "rotated" {
+	"key_name" = "%s"
+	"rotate_on_change" {
+		"rotation" = "%s"
+	}
+}
+`, keyName, rotation)
+}
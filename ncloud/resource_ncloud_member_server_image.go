@@ -0,0 +1,306 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudMemberServerImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudMemberServerImageCreate,
+		Read:   resourceNcloudMemberServerImageRead,
+		Update: resourceNcloudMemberServerImageUpdate,
+		Delete: resourceNcloudMemberServerImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Server instance number to create the member server image from. The server must be stopped.",
+			},
+			"member_server_image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(3, 30),
+				Description:  "Member server image name to create. default: Assigned by ncloud",
+			},
+			"member_server_image_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Member server image description to create",
+			},
+
+			"member_server_image_no": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image no",
+			},
+			"original_server_instance_no": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server instance no",
+			},
+			"original_server_product_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server product code",
+			},
+			"original_server_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server name",
+			},
+			"original_base_block_storage_disk_type": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        commonCodeSchemaResource,
+				Description: "Original base block storage disk type",
+			},
+			"original_server_image_product_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server image product code",
+			},
+			"original_os_information": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original os information",
+			},
+			"original_server_image_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server image name",
+			},
+			"member_server_image_status_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image status name",
+			},
+			"member_server_image_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        commonCodeSchemaResource,
+				Description: "Member server image status",
+			},
+			"member_server_image_operation": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        commonCodeSchemaResource,
+				Description: "Member server image operation",
+			},
+			"member_server_image_platform_type": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        commonCodeSchemaResource,
+				Description: "Member server image platform type",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the member server image",
+			},
+			"region": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        regionSchemaResource,
+				Description: "Region info",
+			},
+			"member_server_image_block_storage_total_rows": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total rows",
+			},
+			"member_server_image_block_storage_total_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total size",
+			},
+		},
+	}
+}
+
+func resourceNcloudMemberServerImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	reqParams := &server.CreateMemberServerImageRequest{
+		ServerInstanceNo:             ncloud.String(d.Get("server_instance_no").(string)),
+		MemberServerImageName:        StringPtrOrNil(d.GetOk("member_server_image_name")),
+		MemberServerImageDescription: StringPtrOrNil(d.GetOk("member_server_image_description")),
+	}
+
+	if err := waitForReferences(client, "ServerInstance", []*string{reqParams.ServerInstanceNo}, lookupServerInstance); err != nil {
+		return err
+	}
+
+	memberServerImage, err := createMemberServerImage(client, reqParams, d.Timeout(schema.TimeoutCreate))
+	if memberServerImage != nil {
+		d.SetId(ncloud.StringValue(memberServerImage.MemberServerImageNo))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceNcloudMemberServerImageRead(d, meta)
+}
+
+func resourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	memberServerImage, err := getMemberServerImage(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if memberServerImage == nil {
+		log.Printf("[WARN] member server image [%s] not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := memberServerImageAttributes(d, memberServerImage); err != nil {
+		return err
+	}
+	d.Set("server_instance_no", memberServerImage.OriginalServerInstanceNo)
+	return nil
+}
+
+func resourceNcloudMemberServerImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	// The server API has no operation to change the description of a member server image.
+	if d.HasChange("member_server_image_description") {
+		// Keep the prior state so the change is planned again on the next run.
+		d.Partial(true)
+		o, n := d.GetChange("member_server_image_description")
+		return fmt.Errorf("changing member_server_image_description of member server image [%s] from %q to %q is not supported by the ncloud server API. "+
+			"revert the change, or taint the resource to recreate the image", d.Id(), o, n)
+	}
+	return resourceNcloudMemberServerImageRead(d, meta)
+}
+
+func resourceNcloudMemberServerImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	if err := deleteMemberServerImage(client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createMemberServerImage creates a member server image and waits until it is created.
+// The image is returned along with a wait error, so the caller can keep track of it.
+func createMemberServerImage(client *NcloudAPIClient, reqParams *server.CreateMemberServerImageRequest, timeout time.Duration) (*server.MemberServerImage, error) {
+	logCommonRequest("CreateMemberServerImage", reqParams)
+	resp, err := client.server.V2Api.CreateMemberServerImage(reqParams)
+	if err != nil {
+		logErrorResponse("CreateMemberServerImage", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("CreateMemberServerImage", GetCommonResponse(resp))
+
+	memberServerImage := resp.MemberServerImageList[0]
+	return memberServerImage, waitForMemberServerImage(client, ncloud.StringValue(memberServerImage.MemberServerImageNo), "CREAT", timeout)
+}
+
+func deleteMemberServerImage(client *NcloudAPIClient, memberServerImageNo string, timeout time.Duration) error {
+	reqParams := &server.DeleteMemberServerImagesRequest{
+		MemberServerImageNoList: []*string{ncloud.String(memberServerImageNo)},
+	}
+
+	logCommonRequest("DeleteMemberServerImages", reqParams)
+	resp, err := client.server.V2Api.DeleteMemberServerImages(reqParams)
+	if err != nil {
+		logErrorResponse("DeleteMemberServerImages", err, reqParams)
+		return err
+	}
+	logCommonResponse("DeleteMemberServerImages", GetCommonResponse(resp))
+
+	return waitForMemberServerImage(client, memberServerImageNo, "TERMT", timeout)
+}
+
+func getMemberServerImageList(client *NcloudAPIClient, reqParams *server.GetMemberServerImageListRequest) ([]*server.MemberServerImage, error) {
+	logCommonRequest("GetMemberServerImageList", reqParams)
+	resp, err := client.server.V2Api.GetMemberServerImageList(reqParams)
+	if err != nil {
+		logErrorResponse("GetMemberServerImageList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetMemberServerImageList", GetCommonResponse(resp))
+
+	return resp.MemberServerImageList, nil
+}
+
+func getMemberServerImage(client *NcloudAPIClient, memberServerImageNo string) (*server.MemberServerImage, error) {
+	memberServerImages, err := getMemberServerImageList(client, &server.GetMemberServerImageListRequest{
+		MemberServerImageNoList: []*string{ncloud.String(memberServerImageNo)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, memberServerImage := range memberServerImages {
+		if ncloud.StringValue(memberServerImage.MemberServerImageNo) == memberServerImageNo {
+			return memberServerImage, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForMemberServerImage waits until the member server image has the given status. A deleted image counts as any status.
+func waitForMemberServerImage(client *NcloudAPIClient, id string, status string, timeout time.Duration) error {
+	c1 := make(chan error, 1)
+
+	go func() {
+		for {
+			memberServerImage, err := getMemberServerImage(client, id)
+			if err != nil {
+				c1 <- err
+				return
+			}
+			// A deleted image is no longer listed, but a new one may not be listed yet.
+			if memberServerImage == nil {
+				if status == "TERMT" {
+					c1 <- nil
+					return
+				}
+				log.Printf("[DEBUG] Wait member server image [%s] to be listed", id)
+				time.Sleep(time.Second * 5)
+				continue
+			}
+			var code string
+			if memberServerImage.MemberServerImageStatus != nil {
+				code = ncloud.StringValue(memberServerImage.MemberServerImageStatus.Code)
+			}
+			if code == status {
+				c1 <- nil
+				return
+			}
+			log.Printf("[DEBUG] Wait member server image [%s] status [%s] to be [%s]", id, code, status)
+			time.Sleep(time.Second * 5)
+		}
+	}()
+
+	select {
+	case res := <-c1:
+		return res
+	case <-time.After(timeout):
+		return fmt.Errorf("TIMEOUT : Wait to member server image (%s)", id)
+	}
+}
@@ -0,0 +1,107 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceNcloudMemberServerImageBasic(t *testing.T) {
+	var memberServerImage server.MemberServerImage
+	prefix := getTestPrefix()
+	testServerName := prefix + "-vm"
+	testImageName := prefix + "-image"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ncloud_member_server_image.image",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckMemberServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberServerImageConfig(testServerName, testImageName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberServerImageExists("ncloud_member_server_image.image", &memberServerImage),
+					resource.TestCheckResourceAttr("ncloud_member_server_image.image", "member_server_image_name", testImageName),
+					resource.TestCheckResourceAttr("ncloud_member_server_image.image", "member_server_image_status.0.code", "CREAT"),
+				),
+			},
+			{
+				ResourceName:      "ncloud_member_server_image.image",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMemberServerImageExists(n string, i *server.MemberServerImage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*NcloudAPIClient)
+		memberServerImage, err := getMemberServerImage(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if memberServerImage == nil {
+			return fmt.Errorf("member server image is not found")
+		}
+
+		*i = *memberServerImage
+		return nil
+	}
+}
+
+func testAccCheckMemberServerImageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*NcloudAPIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_member_server_image" {
+			continue
+		}
+		memberServerImage, err := getMemberServerImage(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if memberServerImage != nil && ncloud.StringValue(memberServerImage.MemberServerImageStatus.Code) != "TERMT" {
+			return fmt.Errorf("found member server image: %s", ncloud.StringValue(memberServerImage.MemberServerImageNo))
+		}
+	}
+
+	return nil
+}
+
+func testAccMemberServerImageConfig(testServerName string, testImageName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%s-key"
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"state" = "stopped"
+}
+
+resource "ncloud_member_server_image" "image" {
+	"server_instance_no" = "${ncloud_server.server.id}"
+	"member_server_image_name" = "%s"
+	"member_server_image_description" = "Terraform test image"
+}
+`, testServerName, testServerName, testImageName)
+}
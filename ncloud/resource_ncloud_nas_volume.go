@@ -7,18 +7,22 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func resourceNcloudNasVolume() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNasVolumeCreate,
-		Read:   resourceNcloudNasVolumeRead,
-		Delete: resourceNcloudNasVolumeDelete,
-		Update: resourceNcloudNasVolumeUpdate,
+		Create:        resourceNcloudNasVolumeCreate,
+		Read:          resourceNcloudNasVolumeRead,
+		Delete:        resourceNcloudNasVolumeDelete,
+		Update:        resourceNcloudNasVolumeUpdate,
+		CustomizeDiff: resourceNcloudNasVolumeCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState:  commonCodeMigrateState("nas_volume_instance_status", "volume_allotment_protocol_type"),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -104,7 +108,7 @@ func resourceNcloudNasVolume() *schema.Resource {
 				Description: "NAS volume name.",
 			},
 			"nas_volume_instance_status": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "NAS Volume instance status",
@@ -115,7 +119,7 @@ func resourceNcloudNasVolume() *schema.Resource {
 				Description: "Creation date of the NAS volume",
 			},
 			"volume_allotment_protocol_type": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        commonCodeSchemaResource,
 				Description: "Volume allotment protocol type.",
@@ -194,6 +198,9 @@ func resourceNcloudNasVolumeCreate(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return nil
 	}
+	if err := waitForReferences(client, "ServerInstance", reqParams.ServerInstanceNoList, lookupServerInstance); err != nil {
+		return err
+	}
 	logCommonRequest("CreateNasVolumeInstance", reqParams)
 
 	resp, err := client.server.V2Api.CreateNasVolumeInstance(reqParams)
@@ -235,16 +242,16 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("is_event_configuration", nasVolume.IsEventConfiguration)
 		d.Set("nas_volume_instance_custom_ip_list", nasVolume.NasVolumeInstanceCustomIpList)
 
-		if err := d.Set("nas_volume_instance_status", flattenCommonCode(nasVolume.NasVolumeInstanceStatus)); err != nil {
+		if err := d.Set("nas_volume_instance_status", structure.FlattenCommonCodeList(nasVolume.NasVolumeInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("volume_allotment_protocol_type", flattenCommonCode(nasVolume.VolumeAllotmentProtocolType)); err != nil {
+		if err := d.Set("volume_allotment_protocol_type", structure.FlattenCommonCodeList(nasVolume.VolumeAllotmentProtocolType)); err != nil {
 			return err
 		}
-		if err := d.Set("zone", flattenZone(nasVolume.Zone)); err != nil {
+		if err := d.Set("zone", structure.FlattenZone(nasVolume.Zone)); err != nil {
 			return err
 		}
-		if err := d.Set("region", flattenRegion(nasVolume.Region)); err != nil {
+		if err := d.Set("region", structure.FlattenRegion(nasVolume.Region)); err != nil {
 			return err
 		}
 	}
@@ -261,6 +268,13 @@ func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceNcloudNasVolumeCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if client, ok := meta.(*NcloudAPIClient); ok {
+		return checkServiceAvailableForDiff(client, ServiceNasVolume, diff)
+	}
+	return nil
+}
+
 func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
@@ -284,8 +298,8 @@ func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("server_instance_no_list") || d.HasChange("custom_ip_list") {
 		reqParams := &server.SetNasVolumeAccessControlRequest{
 			NasVolumeInstanceNo:  ncloud.String(d.Id()),
-			ServerInstanceNoList: expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
-			CustomIpList:         expandStringInterfaceList(d.Get("custom_ip_list").([]interface{})),
+			ServerInstanceNoList: structure.ExpandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
+			CustomIpList:         structure.ExpandStringInterfaceList(d.Get("custom_ip_list").([]interface{})),
 		}
 
 		logCommonRequest("SetNasVolumeAccessControl", reqParams)
@@ -314,8 +328,8 @@ func buildCreateNasVolumeInstanceParams(client *NcloudAPIClient, d *schema.Resou
 		VolumeName:                      ncloud.String(d.Get("volume_name_postfix").(string)),
 		VolumeSize:                      ncloud.Int32(int32(d.Get("volume_size_gb").(int))),
 		VolumeAllotmentProtocolTypeCode: ncloud.String(d.Get("volume_allotment_protocol_type_code").(string)),
-		ServerInstanceNoList:            expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
-		CustomIpList:                    expandStringInterfaceList(d.Get("custom_ip_list").([]interface{})),
+		ServerInstanceNoList:            structure.ExpandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
+		CustomIpList:                    structure.ExpandStringInterfaceList(d.Get("custom_ip_list").([]interface{})),
 		CifsUserName:                    ncloud.String(d.Get("cifs_user_name").(string)),
 		CifsUserPassword:                ncloud.String(d.Get("cifs_user_password").(string)),
 		NasVolumeDescription:            ncloud.String(d.Get("nas_volume_description").(string)),
@@ -344,6 +358,48 @@ func getNasVolumeInstance(client *NcloudAPIClient, nasVolumeInstanceNo string) (
 	return nil, nil
 }
 
+// removeServerFromNasVolumes removes the server from the access control of every NAS volume it can mount.
+func removeServerFromNasVolumes(client *NcloudAPIClient, serverInstanceNo string) error {
+	reqParams := &server.GetNasVolumeInstanceListRequest{}
+	logCommonRequest("GetNasVolumeInstanceList", reqParams)
+
+	resp, err := client.server.V2Api.GetNasVolumeInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetNasVolumeInstanceList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetNasVolumeInstanceList", GetCommonResponse(resp))
+
+	for _, inst := range filterNasVolumesByServerInstanceNo(resp.NasVolumeInstanceList, serverInstanceNo) {
+		reqParams := &server.RemoveNasVolumeAccessControlRequest{
+			NasVolumeInstanceNo:  inst.NasVolumeInstanceNo,
+			ServerInstanceNoList: []*string{ncloud.String(serverInstanceNo)},
+		}
+		logCommonRequest("RemoveNasVolumeAccessControl", reqParams)
+
+		resp, err := client.server.V2Api.RemoveNasVolumeAccessControl(reqParams)
+		if err != nil {
+			logErrorResponse("RemoveNasVolumeAccessControl", err, reqParams)
+			return err
+		}
+		logCommonResponse("RemoveNasVolumeAccessControl", GetCommonResponse(resp))
+	}
+	return nil
+}
+
+func filterNasVolumesByServerInstanceNo(nasVolumeInstances []*server.NasVolumeInstance, serverInstanceNo string) []*server.NasVolumeInstance {
+	var filtered []*server.NasVolumeInstance
+	for _, inst := range nasVolumeInstances {
+		for _, serverInstance := range inst.NasVolumeServerInstanceList {
+			if ncloud.StringValue(serverInstance.ServerInstanceNo) == serverInstanceNo {
+				filtered = append(filtered, inst)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func deleteNasVolumeInstance(client *NcloudAPIClient, nasVolumeInstanceNo string) error {
 	reqParams := &server.DeleteNasVolumeInstanceRequest{NasVolumeInstanceNo: ncloud.String(nasVolumeInstanceNo)}
 	logCommonRequest("DeleteNasVolumeInstance", reqParams)
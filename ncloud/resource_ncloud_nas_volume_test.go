@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -203,3 +204,32 @@ resource "ncloud_nas_volume" "test" {
 	"custom_ip_list" = ["10.10.10.1", "10.10.10.2"]
 }`, volumeNamePostfix)
 }
+
+func TestFilterNasVolumesByServerInstanceNo(t *testing.T) {
+	nasVolumes := []*server.NasVolumeInstance{
+		{
+			NasVolumeInstanceNo: ncloud.String("1"),
+			NasVolumeServerInstanceList: []*server.ServerInstance{
+				{ServerInstanceNo: ncloud.String("100")},
+				{ServerInstanceNo: ncloud.String("200")},
+			},
+		},
+		{
+			NasVolumeInstanceNo: ncloud.String("2"),
+			NasVolumeServerInstanceList: []*server.ServerInstance{
+				{ServerInstanceNo: ncloud.String("300")},
+			},
+		},
+		{
+			NasVolumeInstanceNo: ncloud.String("3"),
+		},
+	}
+
+	filtered := filterNasVolumesByServerInstanceNo(nasVolumes, "200")
+	if len(filtered) != 1 || ncloud.StringValue(filtered[0].NasVolumeInstanceNo) != "1" {
+		t.Fatalf("expected only NAS volume 1, got %d NAS volumes", len(filtered))
+	}
+	if filtered := filterNasVolumesByServerInstanceNo(nasVolumes, "400"); len(filtered) != 0 {
+		t.Fatalf("expected no NAS volume, got %d", len(filtered))
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -70,6 +71,11 @@ func resourceNcloudPortForwadingRule() *schema.Resource {
 func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if err := waitForReferences(client, "ServerInstance", []*string{ncloud.String(serverInstanceNo)}, lookupServerInstance); err != nil {
+		return err
+	}
+
 	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
 	if err != nil {
 		return err
@@ -84,7 +90,6 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 		portForwardingInternalPort = int32(v.(int))
 	}
 
-	serverInstanceNo := d.Get("server_instance_no").(string)
 	zoneNo, err := getServerZoneNo(client, serverInstanceNo)
 	newPortForwardingRuleId := PortForwardingRuleId(portForwardingConfigurationNo, zoneNo, portForwardingExternalPort)
 	log.Printf("[DEBUG] AddPortForwardingRules newPortForwardingRuleId: %s", newPortForwardingRuleId)
@@ -146,7 +151,7 @@ func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface
 		d.Set("port_forwarding_external_port", portForwardingRule.PortForwardingExternalPort)
 		d.Set("port_forwarding_internal_port", portForwardingRule.PortForwardingInternalPort)
 
-		if err := d.Set("zone", flattenZone(resp.Zone)); err != nil {
+		if err := d.Set("zone", structure.FlattenZone(resp.Zone)); err != nil {
 			return err
 		}
 
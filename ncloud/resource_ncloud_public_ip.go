@@ -7,6 +7,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -19,6 +20,8 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState:  commonCodeMigrateState("internet_line_type", "public_ip_instance_status", "public_ip_instance_operation", "public_ip_kind_type"),
 
 		Schema: map[string]*schema.Schema{
 			"server_instance_no": {
@@ -76,7 +79,7 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 				Computed: true,
 			},
 			"internet_line_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -85,17 +88,17 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 				Computed: true,
 			},
 			"public_ip_instance_status": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"public_ip_instance_operation": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"public_ip_kind_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -110,6 +113,9 @@ func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+	if err := waitForReferences(client, "ServerInstance", []*string{reqParams.ServerInstanceNo}, lookupServerInstance); err != nil {
+		return err
+	}
 	logCommonRequest("CreatePublicIpInstance", reqParams)
 
 	resp, err := client.server.V2Api.CreatePublicIpInstance(reqParams)
@@ -145,16 +151,16 @@ func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("create_date", instance.CreateDate)
 		d.Set("public_ip_instance_status_name", instance.PublicIpInstanceStatusName)
 
-		if err := d.Set("internet_line_type", flattenCommonCode(instance.InternetLineType)); err != nil {
+		if err := d.Set("internet_line_type", structure.FlattenCommonCodeList(instance.InternetLineType)); err != nil {
 			return err
 		}
-		if err := d.Set("public_ip_instance_status", flattenCommonCode(instance.PublicIpInstanceStatus)); err != nil {
+		if err := d.Set("public_ip_instance_status", structure.FlattenCommonCodeList(instance.PublicIpInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("public_ip_instance_operation", flattenCommonCode(instance.PublicIpInstanceOperation)); err != nil {
+		if err := d.Set("public_ip_instance_operation", structure.FlattenCommonCodeList(instance.PublicIpInstanceOperation)); err != nil {
 			return err
 		}
-		if err := d.Set("public_ip_kind_type", flattenCommonCode(instance.PublicIpKindType)); err != nil {
+		if err := d.Set("public_ip_kind_type", structure.FlattenCommonCodeList(instance.PublicIpKindType)); err != nil {
 			return err
 		}
 	}
@@ -173,6 +179,12 @@ func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if !client.features.PublicIp.ReleaseOnDestroy {
+		log.Printf("[INFO] Keep public ip instance [%s] since features.public_ip.release_on_destroy is false", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	// Step 3 : public ip 삭제
 	reqParams := &server.DeletePublicIpInstancesRequest{
 		PublicIpInstanceNoList: ncloud.StringList([]string{d.Id()}),
@@ -269,8 +281,23 @@ func disassociatedPublicIp(client *NcloudAPIClient, publicIpInstanceNo string) e
 	return waitDisassociatePublicIp(client, publicIpInstanceNo)
 }
 
+// disassociatePublicIpsOfServer disassociates the public IPs associated with the server. The public IPs are kept.
+func disassociatePublicIpsOfServer(client *NcloudAPIClient, serverInstanceNo string) error {
+	publicIpInstances, err := getPublicIpInstanceList(client, &server.GetPublicIpInstanceListRequest{}, serverInstanceNo)
+	if err != nil {
+		return err
+	}
+	for _, instance := range publicIpInstances {
+		if err := disassociatedPublicIp(client, ncloud.StringValue(instance.PublicIpInstanceNo)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func waitDisassociatePublicIp(client *NcloudAPIClient, publicIPInstanceNo string) error {
 	reqParams := new(server.GetPublicIpInstanceListRequest)
+	reqParams.IsAssociated = ncloud.Bool(true)
 	reqParams.PublicIpInstanceNoList = ncloud.StringList([]string{publicIPInstanceNo})
 
 	c1 := make(chan error, 1)
@@ -0,0 +1,120 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNcloudPublicIpAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudPublicIpAssociationCreate,
+		Read:   resourceNcloudPublicIpAssociationRead,
+		Delete: resourceNcloudPublicIpAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"public_ip_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Public IP instance No. to associate with the server",
+			},
+			"server_instance_no": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Server instance No. to associate the public IP with",
+			},
+
+			"public_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public IP address",
+			},
+		},
+	}
+}
+
+func resourceNcloudPublicIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	publicIpInstanceNo := d.Get("public_ip_instance_no").(string)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if err := waitForReferences(client, "ServerInstance", []*string{ncloud.String(serverInstanceNo)}, lookupServerInstance); err != nil {
+		return err
+	}
+
+	reqParams := &server.AssociatePublicIpWithServerInstanceRequest{
+		PublicIpInstanceNo: ncloud.String(publicIpInstanceNo),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
+	}
+
+	logCommonRequest("AssociatePublicIpWithServerInstance", reqParams)
+	resp, err := client.server.V2Api.AssociatePublicIpWithServerInstance(reqParams)
+	if err != nil {
+		logErrorResponse("AssociatePublicIpWithServerInstance", err, reqParams)
+		return err
+	}
+	logCommonResponse("AssociatePublicIpWithServerInstance", GetCommonResponse(resp))
+
+	d.SetId(publicIpInstanceNo)
+
+	if err := waitPublicIpInstance(client, publicIpInstanceNo, "USED"); err != nil {
+		return err
+	}
+
+	return resourceNcloudPublicIpAssociationRead(d, meta)
+}
+
+func resourceNcloudPublicIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	instance, err := getPublicIpInstance(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	serverInstanceNo := publicIpAssociatedServerInstanceNo(instance)
+	if serverInstanceNo == "" {
+		log.Printf("[WARN] Public ip instance [%s] is not associated with a server instance, removing association from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("public_ip_instance_no", instance.PublicIpInstanceNo)
+	d.Set("server_instance_no", serverInstanceNo)
+	d.Set("public_ip", instance.PublicIp)
+
+	return nil
+}
+
+func resourceNcloudPublicIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*NcloudAPIClient)
+
+	associated, err := checkAssociatedPublicIp(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if associated {
+		if err := disassociatedPublicIp(client, d.Id()); err != nil {
+			return fmt.Errorf("error disassociating public ip instance [%s]: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// publicIpAssociatedServerInstanceNo returns the server instance the public IP is associated with, or "" if none.
+func publicIpAssociatedServerInstanceNo(instance *server.PublicIpInstance) string {
+	if instance == nil || instance.ServerInstanceAssociatedWithPublicIp == nil {
+		return ""
+	}
+	return ncloud.StringValue(instance.ServerInstanceAssociatedWithPublicIp.ServerInstanceNo)
+}
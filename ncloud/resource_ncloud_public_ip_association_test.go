@@ -0,0 +1,83 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceNcloudPublicIpAssociationBasic(t *testing.T) {
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicIpAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicIpAssociationConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ncloud_public_ip_association.association", "server_instance_no",
+						"ncloud_server.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_public_ip_association.association", "public_ip",
+						"ncloud_public_ip.public_ip", "public_ip"),
+				),
+			},
+			{
+				ResourceName:      "ncloud_public_ip_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPublicIpAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*NcloudAPIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_public_ip_association" {
+			continue
+		}
+
+		instance, err := getPublicIpInstance(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if publicIpAssociatedServerInstanceNo(instance) != "" {
+			return fmt.Errorf("public ip instance [%s] is still associated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccPublicIpAssociationConfig(testServerName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%[1]s-key"
+}
+
+resource "ncloud_server" "test" {
+	"server_name" = "%[1]s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_public_ip" "public_ip" {
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_public_ip_association" "association" {
+	"public_ip_instance_no" = "${ncloud_public_ip.public_ip.id}"
+	"server_instance_no" = "${ncloud_server.test.id}"
+}
+`, testServerName)
+}
@@ -1,16 +1,44 @@
 package ncloud
 
 import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// Power states of the `state` argument
+const (
+	ServerStateRunning = "running"
+	ServerStateStopped = "stopped"
+)
+
+// Values of the `base_block_storage_disk_detail_type_code` argument
+const (
+	ServerDiskDetailTypeSSD = "SSD"
+	ServerDiskDetailTypeHDD = "HDD"
+)
+
+// Values of the `shutdown_behavior` argument
+const (
+	ServerShutdownGraceful = "graceful"
+	ServerShutdownForce    = "force"
+)
+
 func resourceNcloudServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNcloudServerCreate,
@@ -18,8 +46,18 @@ func resourceNcloudServer() *schema.Resource {
 		Delete: resourceNcloudServerDelete,
 		Update: resourceNcloudServerUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceNcloudServerImport,
 		},
+		CustomizeDiff: resourceNcloudServerCustomizeDiff,
+		SchemaVersion: 1,
+		MigrateState: commonCodeMigrateState(
+			"platform_type",
+			"server_instance_status",
+			"server_instance_operation",
+			"base_block_storage_disk_type",
+			"base_block_storage_disk_detail_type",
+			"internet_line_type",
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -29,6 +67,8 @@ func resourceNcloudServer() *schema.Resource {
 			"server_image_product_code": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
 				Description: "Server image product code to determine which server image to create. It can be obtained through getServerImageProductList. You are required to select one among two parameters: server image product code (server_image_product_code) and member server image number(member_server_image_no).",
 			},
 			"server_product_code": {
@@ -36,27 +76,65 @@ func resourceNcloudServer() *schema.Resource {
 				Optional:    true,
 				Description: "Server product code to determine the server specification to create. It can be obtained through the getServerProductList action. Default : Selected as minimum specification. The minimum standards are 1. memory 2. CPU 3. basic block storage size 4. disk type (NET,LOCAL)",
 			},
+			"base_block_storage_disk_detail_type_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIncludeValues([]string{ServerDiskDetailTypeSSD, ServerDiskDetailTypeHDD}),
+				Description:  "Disk detail type of the base block storage. SSD or HDD. It must match `server_product_code`; when `server_product_code` is not set, the minimum specification with this disk detail type is selected.",
+			},
 			"member_server_image_no": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Required value when creating a server from a manually created server image. It can be obtained through the getMemberServerImageList action.",
 			},
+			"member_server_image_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"member_server_image_no"},
+				Description:   "Name of the member server image to create the server from. It is resolved to the member server image number when the server is created.",
+			},
 			"server_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validateServerName,
 				Description:  "Server name to create. default: Assigned by ncloud",
 			},
+			"server_name_random_suffix": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Append a random suffix to `server_name` when creating the server, so a replacement server can be created before the old one is destroyed. Changing it replaces the server. default: false",
+			},
+			"ignore_resource_name_affixes": ignoreResourceNameAffixesSchema(),
 			"server_description": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Server description to create",
 			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key/value metadata of the server. Each entry is stored as an instance tag whose key is prefixed with `metadata:`.",
+			},
 			"login_key_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The login key name to encrypt with the public key. Default : Uses the most recently created login key name",
 			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Private key of the login key (e.g. `ncloud_login_key.private_key`). When set, `root_password` is computed.",
+			},
 			"is_protect_server_termination": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -96,24 +174,177 @@ func resourceNcloudServer() *schema.Resource {
 			"user_data": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The server will execute the user data script set by the user at first boot. To view the column, it is returned only when viewing the server instance. You must need base64 Encoding, URL Encoding before put in value of userData. If you don't URL Encoding again it occurs signature invalid error.",
+				ForceNew:    true,
+				StateFunc:   userDataHashSum,
+				Description: "The server will execute the user data script set by the user at first boot. To view the column, it is returned only when viewing the server instance. Write the script as plain text; it is base64 and URL encoded by the provider before it is sent.",
+			},
+			"hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostname,
+				Description:  "Hostname of the OS, set by commands added to the user data script at first boot",
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTimezone,
+				Description:  "Time zone of the OS, e.g. Asia/Seoul on Linux or Korea Standard Time on Windows, set by commands added to the user data script at first boot",
+			},
+			"install_gpu_driver": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Install the NVIDIA driver of a GPU server at first boot, by commands added to the user data script. default: false",
 			},
 			"raid_type_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Raid Type Name",
+				Description: "Raid Type Name of a bare metal server. Get available values using the getRaidList action.",
 			},
 			"tag_list": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        tagListSchemaResource,
 				Description: "Instance tag list",
 			},
+			"network_interface": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        networkInterfaceSchemaResource,
+				Description: "Additional network interfaces of the server in private subnets",
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Wait after creation until a TCP port of the server accepts connections, or an HTTP path answers with a 2xx status.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validateIntegerInRange(1, 65535),
+							Description:  "TCP port to check. default: 22",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "HTTP path to check on the port, e.g. `/health`. When set, the server is ready once it answers with a 2xx status.",
+						},
+						"address_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "auto",
+							ValidateFunc: validateIncludeValues([]string{"auto", "public", "private"}),
+							Description:  "IP address to check. `auto` (public IP, or private IP when the server has none) | `public` | `private`. default: auto",
+						},
+						"timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "10m",
+							Description: "How long to wait for the port, e.g. `10m`. default: 10m",
+						},
+					},
+				},
+			},
+			"collect_ssh_host_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Collect the SSH host keys of the server on port 22 after creation, so they can be pinned instead of trusted on first use. default: false",
+			},
+			"unmanaged_network_interfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Network interfaces attached to the server that are not in `network_interface`, e.g. attached outside of Terraform",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ssh_host_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        sshHostKeySchemaResource,
+				Description: "SSH host keys of the server, collected when `collect_ssh_host_keys` is set",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIncludeValues([]string{ServerStateRunning, ServerStateStopped}),
+				Description:  "Power state of the server. Accepted values: running | stopped. Default: running",
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Release the dependencies of the server on destroy: deregister it from load balancers, disassociate its public IPs, remove it from NAS volume access control and detach its block storages. default: false",
+			},
+			"allow_stop_for_resize": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Stop a running server to change its `server_product_code`, and start it again afterwards. default: true",
+			},
+			"auto_image_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create a member server image of the server, named after the server and the time, before destroying it. default: false",
+			},
+			"auto_image_retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerInRange(0, 100),
+				Description:  "Number of images created by `auto_image_on_destroy` to keep for the server name. Older ones are deleted. 0 keeps all of them. default: 0",
+			},
+			"shutdown_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ServerShutdownForce,
+				ValidateFunc: validateIncludeValues([]string{ServerShutdownGraceful, ServerShutdownForce}),
+				Description:  "What to do when the server does not stop within `shutdown_timeout` on destroy. `force` terminates the server anyway, `graceful` fails the destroy. default: force",
+			},
+			"shutdown_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "5m",
+				ValidateFunc: validateDuration,
+				Description:  "How long to wait for the OS-level stop before applying `shutdown_behavior`, e.g. `5m`. default: 5m",
+			},
 
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"root_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Root password of the server, decrypted with `private_key`",
+			},
 			"cpu_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -127,7 +358,7 @@ func resourceNcloudServer() *schema.Resource {
 				Computed: true,
 			},
 			"platform_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -148,12 +379,12 @@ func resourceNcloudServer() *schema.Resource {
 				Computed: true,
 			},
 			"server_instance_status": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"server_instance_operation": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
@@ -192,22 +423,134 @@ func resourceNcloudServer() *schema.Resource {
 				Elem:     regionSchemaResource,
 			},
 			"base_block_storage_disk_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"base_block_storage_disk_detail_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
 			"internet_line_type": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     commonCodeSchemaResource,
 			},
+			"server_instance_type": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        commonCodeSchemaResource,
+				Description: "Server instance type, e.g. standard, GPU or bare metal",
+			},
+		},
+	}
+}
+
+// serverDisruptiveChanges are the in-place updates of ncloud_server that interrupt the server.
+var serverDisruptiveChanges = []disruptiveChange{
+	{
+		Key:    "server_product_code",
+		Impact: "stops the server, changes its spec and starts it again",
+		Applies: func(diff resourceChangeReader) bool {
+			return diff.Get("allow_stop_for_resize").(bool) && diff.Get("state").(string) != ServerStateStopped &&
+				!isBareMetalServerRespecified(diff)
+		},
+	},
+	{
+		Key:    "state",
+		Impact: "stops the server",
+		Applies: func(diff resourceChangeReader) bool {
+			return diff.Get("state").(string) == ServerStateStopped
 		},
+	},
+	{
+		Key:    "network_interface",
+		Impact: "detaches and deletes the removed or changed network interfaces",
+	},
+}
+
+// serverUnchangeableKeys are the arguments the server API cannot change on an existing server.
+var serverUnchangeableKeys = []string{"server_name", "server_description", "is_protect_server_termination", "access_control_group_configuration_no_list", "fee_system_type_code"}
+
+// isServerReplaced reports whether the diff changes an argument that replaces the server.
+func isServerReplaced(diff *schema.ResourceDiff) bool {
+	if isBareMetalServerRespecified(diff) {
+		return true
+	}
+	for key, s := range resourceNcloudServer().Schema {
+		if s.ForceNew && diff.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBareMetalServerRespecified reports whether the diff changes the product code of an existing bare metal server,
+// or changes an existing server to or from a bare metal product.
+func isBareMetalServerRespecified(diff resourceChangeReader) bool {
+	if diff.Id() == "" || !diff.HasChange("server_product_code") {
+		return false
+	}
+	o, n := diff.GetChange("server_product_code")
+	return isBareMetalServerProductCode(o.(string)) || isBareMetalServerProductCode(n.(string))
+}
+
+func resourceNcloudServerCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	warnDisruptiveChanges("ncloud_server", diff, serverDisruptiveChanges)
+
+	// The specification of a bare metal server cannot be changed, so changing its product code replaces it.
+	if isBareMetalServerRespecified(diff) {
+		if err := diff.ForceNew("server_product_code"); err != nil {
+			return err
+		}
+	}
+
+	// The server API has no operation to rename a server, change its description, its termination protection, its ACGs
+	// or its fee system. Fail at plan time instead of recording a change that is never applied, unless the server is replaced anyway.
+	if diff.Id() != "" && !isServerReplaced(diff) {
+		for _, key := range serverUnchangeableKeys {
+			if diff.HasChange(key) {
+				o, n := diff.GetChange(key)
+				return fmt.Errorf("changing %s of server instance [%s] from %v to %v is not supported by the ncloud server API. "+
+					"revert the change, or taint the resource to recreate the server", key, diff.Id(), o, n)
+			}
+		}
+	}
+
+	var suffixLength int
+	if diff.Get("server_name_random_suffix").(bool) {
+		suffixLength = serverNameRandomSuffixLength
+	}
+	if err := validateResourceNameLengthForDiff(diff, meta, "server_name", suffixLength); err != nil {
+		return err
+	}
+
+	// Instance tags with the metadata prefix are read back as `metadata`, so they would never match `tag_list`.
+	for _, tag := range diff.Get("tag_list").(*schema.Set).List() {
+		if key := tag.(map[string]interface{})["tag_key"].(string); strings.HasPrefix(key, serverMetadataTagPrefix) {
+			return fmt.Errorf("tag_key %s of tag_list is reserved for metadata. set it in metadata instead", key)
+		}
+	}
+
+	if v, ok := diff.GetOk("base_block_storage_disk_detail_type_code"); ok {
+		code := diff.Get("server_product_code").(string)
+		if code != "" && serverProductDiskDetailType(code) != v.(string) {
+			return fmt.Errorf("server_product_code %s has a %s base block storage, but base_block_storage_disk_detail_type_code is %s",
+				code, serverProductDiskDetailType(code), v.(string))
+		}
 	}
+
+	if diff.Get("install_gpu_driver").(bool) {
+		if code, ok := knownString(diff, "server_product_code"); ok && !isGpuServerProductCode(code) {
+			return fmt.Errorf("install_gpu_driver needs a GPU server_product_code, but server_product_code is %s", code)
+		}
+	}
+
+	if client, ok := meta.(*NcloudAPIClient); ok && client.preflightValidation && diff.Id() == "" {
+		return preflightServerCreate(client, diff)
+	}
+	return nil
 }
 
 func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -218,9 +561,30 @@ func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err := validateRaidTypeName(client, ncloud.StringValue(reqParams.RaidTypeName)); err != nil {
+		return err
+	}
+
+	// A GPU product the image does not support is only reported as a boot failure, so it is checked first.
+	if imageCode := ncloud.StringValue(reqParams.ServerImageProductCode); imageCode != "" && isGpuServerProductCode(ncloud.StringValue(reqParams.ServerProductCode)) {
+		if err := preflightServerProductAvailable(client, imageCode, ncloud.StringValue(reqParams.ServerProductCode), reqParams.ZoneNo); err != nil {
+			return fmt.Errorf("GPU server product is not supported by the server image: %s", err)
+		}
+	}
+
+	if err := waitForReferences(client, "AccessControlGroup", reqParams.AccessControlGroupConfigurationNoList, lookupAccessControlGroup); err != nil {
+		return err
+	}
+	if err := waitForReferences(client, "LoginKey", []*string{reqParams.LoginKeyName}, lookupLoginKey); err != nil {
+		return err
+	}
+
+	userData := ncloud.StringValue(reqParams.UserData)
 	var resp *server.CreateServerInstancesResponse
 	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
 		var err error
+		// The SDK base64 encodes UserData in place, so it must be reset to the raw script before every attempt.
+		reqParams.UserData = ncloud.String(userData)
 		logCommonRequest("CreateServerInstances", reqParams)
 		resp, err = client.server.V2Api.CreateServerInstances(reqParams)
 
@@ -240,12 +604,77 @@ func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error
 	serverInstance := resp.ServerInstanceList[0]
 	d.SetId(ncloud.StringValue(serverInstance.ServerInstanceNo))
 
-	if err := waitForServerInstance(client, ncloud.StringValue(serverInstance.ServerInstanceNo), "RUN"); err != nil {
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if isBareMetalServerProductCode(d.Get("server_product_code").(string)) && timeout < DefaultBareMetalCreateTimeout {
+		timeout = DefaultBareMetalCreateTimeout
+	}
+	if err := waitForServerInstanceWithTimeout(client, ncloud.StringValue(serverInstance.ServerInstanceNo), "RUN", timeout); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("network_interface"); ok {
+		zoneNo, err := getServerZoneNo(client, d.Id())
+		if err != nil {
+			return err
+		}
+		if err := createServerNetworkInterfaces(client, d.Id(), zoneNo, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("wait_for_ready"); ok {
+		if err := waitForServerReady(client, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if err := setServerSSHHostKeys(d, client); err != nil {
 		return err
 	}
+
+	setServerRootPassword(d, client)
+
+	if d.Get("state").(string) == ServerStateStopped {
+		if err := changeServerState(client, d.Id(), ServerStateStopped); err != nil {
+			return err
+		}
+	}
 	return resourceNcloudServerRead(d, meta)
 }
 
+// resourceNcloudServerImport imports the network interfaces of the server into `network_interface`,
+// which is otherwise only filled from the configuration.
+func resourceNcloudServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*NcloudAPIClient)
+
+	instance, err := getServerInstance(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("server instance [%s] not found", d.Id())
+	}
+	if instance.Zone == nil || instance.Region == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	nics, err := getServerNetworkInterfaces(client, d.Id(), ncloud.StringValue(instance.Zone.ZoneNo))
+	if err != nil {
+		return nil, err
+	}
+	if len(nics) > 0 {
+		subnets, err := getPrivateSubnetInstanceList(client, instance.Region.RegionNo)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("network_interface", importServerNetworkInterfaces(nics, subnets)); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
@@ -256,8 +685,17 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 
 	if instance != nil {
 		d.Set("server_instance_no", instance.ServerInstanceNo)
-		d.Set("server_name", instance.ServerName)
+		// Keep the configured name when the server was created with a random suffix or the provider name affixes.
+		serverName := ncloud.StringValue(instance.ServerName)
+		if d.Get("server_name_random_suffix").(bool) && trimResourceNameAffixes(client, d, trimServerNameSuffix(serverName)) == d.Get("server_name").(string) {
+			serverName = d.Get("server_name").(string)
+		} else {
+			serverName = trimResourceNameAffixes(client, d, serverName)
+		}
+		d.Set("server_name", serverName)
+		d.Set("server_description", instance.ServerDescription)
 		d.Set("server_image_product_code", instance.ServerImageProductCode)
+		d.Set("is_protect_server_termination", instance.IsProtectServerTermination)
 		d.Set("server_instance_status_name", instance.ServerInstanceStatusName)
 		d.Set("uptime", instance.Uptime)
 		d.Set("server_image_name", instance.ServerImageName)
@@ -273,34 +711,62 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("port_forwarding_public_ip", instance.PortForwardingPublicIp)
 		d.Set("port_forwarding_external_port", instance.PortForwardingExternalPort)
 		d.Set("port_forwarding_internal_port", instance.PortForwardingInternalPort)
-		d.Set("user_data", d.Get("user_data").(string))
+		if state := serverStateFromStatusCode(ncloud.StringValue(instance.ServerInstanceStatus.Code)); state != "" {
+			d.Set("state", state)
+		}
 
-		if err := d.Set("server_instance_status", flattenCommonCode(instance.ServerInstanceStatus)); err != nil {
+		if err := d.Set("server_instance_status", structure.FlattenCommonCodeList(instance.ServerInstanceStatus)); err != nil {
 			return err
 		}
-		if err := d.Set("platform_type", flattenCommonCode(instance.PlatformType)); err != nil {
+		if err := d.Set("platform_type", structure.FlattenCommonCodeList(instance.PlatformType)); err != nil {
 			return err
 		}
-		if err := d.Set("server_instance_operation", flattenCommonCode(instance.ServerInstanceOperation)); err != nil {
+		if err := d.Set("server_instance_operation", structure.FlattenCommonCodeList(instance.ServerInstanceOperation)); err != nil {
 			return err
 		}
-		if err := d.Set("zone", flattenZone(instance.Zone)); err != nil {
+		if err := d.Set("zone", structure.FlattenZone(instance.Zone)); err != nil {
 			return err
 		}
-		if err := d.Set("region", flattenRegion(instance.Region)); err != nil {
+		if err := d.Set("region", structure.FlattenRegion(instance.Region)); err != nil {
 			return err
 		}
-		if err := d.Set("base_block_storage_disk_type", flattenCommonCode(instance.BaseBlockStorageDiskType)); err != nil {
+		if err := d.Set("base_block_storage_disk_type", structure.FlattenCommonCodeList(instance.BaseBlockStorageDiskType)); err != nil {
 			return err
 		}
-		if err := d.Set("base_block_storage_disk_detail_type", flattenCommonCode(instance.BaseBlockStroageDiskDetailType)); err != nil {
+		if err := d.Set("base_block_storage_disk_detail_type", structure.FlattenCommonCodeList(instance.BaseBlockStroageDiskDetailType)); err != nil {
 			return err
 		}
-		if err := d.Set("internet_line_type", flattenCommonCode(instance.InternetLineType)); err != nil {
+		if instance.BaseBlockStroageDiskDetailType != nil {
+			d.Set("base_block_storage_disk_detail_type_code", instance.BaseBlockStroageDiskDetailType.Code)
+		}
+		if err := d.Set("internet_line_type", structure.FlattenCommonCodeList(instance.InternetLineType)); err != nil {
 			return err
 		}
-		if len(instance.InstanceTagList) != 0 {
-			d.Set("load_balancer_rule_list", flattenInstanceTagList(instance.InstanceTagList))
+		if err := d.Set("server_instance_type", structure.FlattenCommonCodeList(instance.ServerInstanceType)); err != nil {
+			return err
+		}
+		if instance.Zone != nil {
+			nics, err := getServerNetworkInterfaces(client, d.Id(), ncloud.StringValue(instance.Zone.ZoneNo))
+			if err != nil {
+				return err
+			}
+			managed, unmanaged := flattenServerNetworkInterfaces(d.Get("network_interface").([]interface{}), nics)
+			if err := d.Set("network_interface", managed); err != nil {
+				return err
+			}
+			if err := d.Set("unmanaged_network_interfaces", unmanaged); err != nil {
+				return err
+			}
+		}
+		if d.Get("private_key").(string) == "" {
+			d.Set("root_password", "")
+		}
+		tagList, metadata := splitServerMetadataTags(instance.InstanceTagList)
+		if err := d.Set("metadata", metadata); err != nil {
+			return err
+		}
+		if err := d.Set("tag_list", flattenInstanceTagList(tagList)); err != nil {
+			return err
 		}
 	}
 
@@ -314,19 +780,51 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if serverInstance != nil && ncloud.BoolValue(serverInstance.IsProtectServerTermination) {
+		return fmt.Errorf("termination protection is enabled on server instance [%s]. disable it in the ncloud console before destroying the server", d.Id())
+	}
+
+	forceDestroy := d.Get("force_destroy").(bool)
+
+	if serverInstance != nil && serverInstance.Region != nil {
+		if err := detachServerFromLoadBalancers(client, serverInstance, forceDestroy); err != nil {
+			return err
+		}
+	}
+
+	if forceDestroy {
+		if err := disassociatePublicIpsOfServer(client, d.Id()); err != nil {
+			return err
+		}
+		if err := removeServerFromNasVolumes(client, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	if serverInstance == nil || ncloud.StringValue(serverInstance.ServerInstanceStatus.Code) != "NSTOP" {
-		if err := stopServerInstance(client, d.Id()); err != nil {
+		if err := shutdownServerInstance(client, d.Id(), d.Get("shutdown_behavior").(string), d.Get("shutdown_timeout").(string)); err != nil {
 			return err
 		}
-		if err := waitForServerInstance(client, ncloud.StringValue(serverInstance.ServerInstanceNo), "NSTOP"); err != nil {
+	}
+
+	if d.Get("auto_image_on_destroy").(bool) && serverInstance != nil {
+		if err := imageServerBeforeDestroy(client, serverInstance, d.Get("auto_image_retention").(int), DefaultCreateTimeout); err != nil {
 			return err
 		}
 	}
 
-	err = detachBlockStorageByServerInstanceNo(d, client, d.Id())
-	if err != nil {
-		log.Printf("[ERROR] detachBlockStorageByServerInstanceNo err: %s", err)
-		return err
+	if v, ok := d.GetOk("network_interface"); ok && serverInstance != nil && serverInstance.Zone != nil {
+		if err := deleteServerNetworkInterfaces(client, d.Id(), ncloud.StringValue(serverInstance.Zone.ZoneNo), v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if forceDestroy || client.features.Server.DetachBlockStorageOnDestroy {
+		err = detachBlockStorageByServerInstanceNo(d, client, d.Id())
+		if err != nil {
+			log.Printf("[ERROR] detachBlockStorageByServerInstanceNo err: %s", err)
+			return err
+		}
 	}
 
 	if err := terminateServerInstance(client, d.Id()); err != nil {
@@ -339,62 +837,204 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NcloudAPIClient)
 
-	if d.HasChange("server_product_code") {
-		reqParams := &server.ChangeServerInstanceSpecRequest{
-			ServerInstanceNo:  ncloud.String(d.Get("server_instance_no").(string)),
-			ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
+	if d.HasChange("network_interface") {
+		zoneNo, err := getServerZoneNo(client, d.Id())
+		if err != nil {
+			return err
+		}
+		o, n := d.GetChange("network_interface")
+		remove, add := diffNetworkInterfaces(o.([]interface{}), n.([]interface{}))
+		if err := deleteServerNetworkInterfaces(client, d.Id(), zoneNo, remove); err != nil {
+			return err
+		}
+		if err := createServerNetworkInterfaces(client, d.Id(), zoneNo, add); err != nil {
+			return err
 		}
+	}
 
-		var resp *server.ChangeServerInstanceSpecResponse
-		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-			var err error
-			logCommonRequest("ChangeServerInstanceSpec", reqParams)
-			resp, err = client.server.V2Api.ChangeServerInstanceSpec(reqParams)
+	if d.HasChange("tag_list") {
+		o, n := d.GetChange("tag_list")
+		if err := updateInstanceTags(client, d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
 
-			if resp != nil && isRetryableErr(GetCommonResponse(resp), []string{ApiErrorUnknown, ApiErrorObjectInOperation, ApiErrorObjectInOperation}) {
-				logErrorResponse("retry ChangeServerInstanceSpec", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		})
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		if err := updateInstanceTags(client, d.Id(), expandServerMetadataTags(o.(map[string]interface{})), expandServerMetadataTags(n.(map[string]interface{}))); err != nil {
+			return err
+		}
+	}
 
-		if err != nil {
-			logErrorResponse("ChangeServerInstanceSpec", err, reqParams)
+	// The spec is changed before the power state, so a server being stopped by the same apply is resized first.
+	if d.HasChange("server_product_code") {
+		if err := resizeServerInstance(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("state") {
+		if err := changeServerState(client, d.Id(), d.Get("state").(string)); err != nil {
 			return err
 		}
-		logCommonResponse("ChangeServerInstanceSpec", GetCommonResponse(resp))
+	}
+
+	if d.HasChange("collect_ssh_host_keys") {
+		if err := setServerSSHHostKeys(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("private_key") {
+		setServerRootPassword(d, client)
 	}
 
 	return resourceNcloudServerRead(d, meta)
 }
 
+// resizeServerInstance changes the server product code of the server. The spec of a running server cannot be changed,
+// so it is stopped before and started again after the change, unless allow_stop_for_resize is false.
+func resizeServerInstance(d *schema.ResourceData, client *NcloudAPIClient) error {
+	instance, err := getServerInstance(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("server instance [%s] not found", d.Id())
+	}
+
+	running := ncloud.StringValue(instance.ServerInstanceStatus.Code) != "NSTOP"
+	if running {
+		if !d.Get("allow_stop_for_resize").(bool) {
+			// Keep the prior state so the change is planned again on the next run.
+			d.Partial(true)
+			return fmt.Errorf("server instance [%s] must be stopped to change server_product_code. "+
+				"stop it, or set allow_stop_for_resize to true", d.Id())
+		}
+		if err := changeServerState(client, d.Id(), ServerStateStopped); err != nil {
+			return err
+		}
+	}
+
+	reqParams := &server.ChangeServerInstanceSpecRequest{
+		ServerInstanceNo:  ncloud.String(d.Get("server_instance_no").(string)),
+		ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
+	}
+
+	var resp *server.ChangeServerInstanceSpecResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		var err error
+		logCommonRequest("ChangeServerInstanceSpec", reqParams)
+		resp, err = client.server.V2Api.ChangeServerInstanceSpec(reqParams)
+
+		if resp != nil && isRetryableErr(GetCommonResponse(resp), []string{ApiErrorUnknown, ApiErrorObjectInOperation, ApiErrorObjectInOperation}) {
+			logErrorResponse("retry ChangeServerInstanceSpec", err, reqParams)
+			time.Sleep(time.Second * 5)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+
+	if err != nil {
+		logErrorResponse("ChangeServerInstanceSpec", err, reqParams)
+		return err
+	}
+	logCommonResponse("ChangeServerInstanceSpec", GetCommonResponse(resp))
+
+	if err := waitForServerProductCode(client, d.Id(), d.Get("server_product_code").(string)); err != nil {
+		return err
+	}
+
+	if running {
+		return changeServerState(client, d.Id(), ServerStateRunning)
+	}
+	return nil
+}
+
+// waitForServerProductCode waits until the spec change of the server is applied.
+func waitForServerProductCode(client *NcloudAPIClient, serverInstanceNo string, serverProductCode string) error {
+	c1 := make(chan error, 1)
+
+	go func() {
+		for {
+			instance, err := getServerInstance(client, serverInstanceNo)
+			if err != nil {
+				c1 <- err
+				return
+			}
+			if instance == nil {
+				c1 <- fmt.Errorf("server instance [%s] not found", serverInstanceNo)
+				return
+			}
+			if ncloud.StringValue(instance.ServerProductCode) == serverProductCode &&
+				(instance.ServerInstanceOperation == nil || ncloud.StringValue(instance.ServerInstanceOperation.Code) == "NULL") {
+				c1 <- nil
+				return
+			}
+			log.Printf("[DEBUG] Wait server instance [%s] product code [%s] to be [%s]", serverInstanceNo, ncloud.StringValue(instance.ServerProductCode), serverProductCode)
+			time.Sleep(time.Second * 3)
+		}
+	}()
+
+	select {
+	case res := <-c1:
+		return res
+	case <-time.After(DefaultUpdateTimeout):
+		return fmt.Errorf("TIMEOUT : Wait to server instance spec change (%s)", serverInstanceNo)
+	}
+}
+
 func buildCreateServerInstanceReqParams(client *NcloudAPIClient, d *schema.ResourceData) (*server.CreateServerInstancesRequest, error) {
 
 	var paramAccessControlGroupConfigurationNoList []*string
 	if param, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
-		paramAccessControlGroupConfigurationNoList = expandStringInterfaceList(param.([]interface{}))
+		paramAccessControlGroupConfigurationNoList = structure.ExpandStringInterfaceList(param.([]interface{}))
 	}
 	zoneNo, err := parseZoneNoParameter(client, d)
 	if err != nil {
 		return nil, err
 	}
+	serverName := addResourceNameAffixes(client, d, d.Get("server_name").(string))
+	if serverName != "" && d.Get("server_name_random_suffix").(bool) {
+		if serverName, err = appendServerNameSuffix(serverName); err != nil {
+			return nil, err
+		}
+	}
+	memberServerImageNo := d.Get("member_server_image_no").(string)
+	if name, ok := d.GetOk("member_server_image_name"); ok {
+		memberServerImageNo, err = getMemberServerImageNoByName(client, name.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+	userData, err := buildServerUserData(client, d, memberServerImageNo)
+	if err != nil {
+		return nil, err
+	}
+	serverProductCode := d.Get("server_product_code").(string)
+	if diskDetailType, ok := d.GetOk("base_block_storage_disk_detail_type_code"); ok && serverProductCode == "" {
+		serverProductCode, err = getServerProductCodeByDiskDetailType(client, d.Get("server_image_product_code").(string), zoneNo, diskDetailType.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
 	reqParams := &server.CreateServerInstancesRequest{
 		ServerImageProductCode:                ncloud.String(d.Get("server_image_product_code").(string)),
-		ServerProductCode:                     ncloud.String(d.Get("server_product_code").(string)),
-		MemberServerImageNo:                   ncloud.String(d.Get("member_server_image_no").(string)),
-		ServerName:                            ncloud.String(d.Get("server_name").(string)),
+		ServerProductCode:                     ncloud.String(serverProductCode),
+		MemberServerImageNo:                   ncloud.String(memberServerImageNo),
+		ServerName:                            ncloud.String(serverName),
 		ServerDescription:                     ncloud.String(d.Get("server_description").(string)),
 		LoginKeyName:                          ncloud.String(d.Get("login_key_name").(string)),
 		InternetLineTypeCode:                  StringPtrOrNil(d.GetOk("internet_line_type_code")),
 		FeeSystemTypeCode:                     ncloud.String(d.Get("fee_system_type_code").(string)),
 		ZoneNo:                                zoneNo,
 		AccessControlGroupConfigurationNoList: paramAccessControlGroupConfigurationNoList,
-		UserData:                              ncloud.String(d.Get("user_data").(string)),
+		UserData:                              ncloud.String(userData),
 		RaidTypeName:                          ncloud.String(d.Get("raid_type_name").(string)),
 	}
 
-	if instanceTagList, err := expandTagListParams(d.Get("tag_list").([]interface{})); err == nil {
+	tags := d.Get("tag_list").(*schema.Set).Union(expandServerMetadataTags(d.Get("metadata").(map[string]interface{})))
+	if instanceTagList, err := structure.ExpandTagListParams(tags.List()); err == nil {
 		reqParams.InstanceTagList = instanceTagList
 	}
 
@@ -405,6 +1045,291 @@ func buildCreateServerInstanceReqParams(client *NcloudAPIClient, d *schema.Resou
 	return reqParams, nil
 }
 
+var serverNameSuffixPattern = regexp.MustCompile(`-[0-9a-f]{4}$`)
+
+// serverNameRandomSuffixLength is the length of the suffix appended by appendServerNameSuffix.
+const serverNameRandomSuffixLength = 5
+
+// appendServerNameSuffix appends a random suffix such as "-1a2b" to the server name.
+func appendServerNameSuffix(name string) (string, error) {
+	b := make([]byte, 2)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	suffixed := fmt.Sprintf("%s-%s", name, hex.EncodeToString(b))
+	if len(suffixed) > 30 {
+		return "", fmt.Errorf("server_name %q is too long for server_name_random_suffix: it must be at most 25 characters", name)
+	}
+	return suffixed, nil
+}
+
+// trimServerNameSuffix removes the suffix appended by appendServerNameSuffix.
+func trimServerNameSuffix(name string) string {
+	return serverNameSuffixPattern.ReplaceAllString(name, "")
+}
+
+// getMemberServerImageNoByName resolves a member server image name to its number.
+func getMemberServerImageNoByName(client *NcloudAPIClient, name string) (string, error) {
+	memberServerImages, err := getMemberServerImageList(client, &server.GetMemberServerImageListRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return findMemberServerImageNoByName(memberServerImages, name)
+}
+
+func findMemberServerImageNoByName(images []*server.MemberServerImage, name string) (string, error) {
+	var found []*server.MemberServerImage
+	for _, image := range images {
+		if ncloud.StringValue(image.MemberServerImageName) == name {
+			found = append(found, image)
+		}
+	}
+
+	if len(found) == 0 {
+		return "", fmt.Errorf("no member server image found with name %q", name)
+	}
+	if len(found) > 1 {
+		return "", fmt.Errorf("more than one member server image found with name %q", name)
+	}
+	return ncloud.StringValue(found[0].MemberServerImageNo), nil
+}
+
+// setServerRootPassword decrypts the root password with `private_key`, and clears it when `private_key` is not set.
+// It is only called on create and when `private_key` changes: the login key may be rotated later, and a key the server
+// was not created with cannot decrypt its password. A failed decryption keeps the stored password.
+func setServerRootPassword(d *schema.ResourceData, client *NcloudAPIClient) {
+	privateKey := d.Get("private_key").(string)
+	if privateKey == "" {
+		d.Set("root_password", "")
+		return
+	}
+
+	rootPassword, err := getRootPassword(client, d.Id(), privateKey)
+	if err != nil {
+		log.Printf("[WARN] unable to decrypt the root password of server instance [%s] with private_key, keeping the stored one: %s", d.Id(), err)
+		return
+	}
+	d.Set("root_password", rootPassword)
+}
+
+// userDataHashSum stores a SHA-1 hash of the raw user data script in the state instead of the script itself,
+// so that the plan compares scripts by content regardless of the encoding applied when they are sent.
+func userDataHashSum(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	hash := sha1.Sum([]byte(s))
+	return hex.EncodeToString(hash[:])
+}
+
+// detachServerFromLoadBalancers deregisters the server from the load balancers it is bound to,
+// or fails listing them when the deregister_from_load_balancers_on_destroy feature is disabled.
+func detachServerFromLoadBalancers(client *NcloudAPIClient, serverInstance *server.ServerInstance, force bool) error {
+	serverInstanceNo := ncloud.StringValue(serverInstance.ServerInstanceNo)
+	lbs, err := getLoadBalancersOfServer(client, serverInstance.Region.RegionNo, serverInstanceNo)
+	if err != nil {
+		return err
+	}
+	if len(lbs) == 0 {
+		return nil
+	}
+
+	if !force && !client.features.Server.DeregisterFromLoadBalancersOnDestroy {
+		var names []string
+		for _, lb := range lbs {
+			names = append(names, fmt.Sprintf("%s (%s)", ncloud.StringValue(lb.LoadBalancerName), ncloud.StringValue(lb.LoadBalancerInstanceNo)))
+		}
+		return fmt.Errorf("server instance [%s] is still bound to load balancers: %s. remove it from their server_instance_no_list, "+
+			"set force_destroy, or enable deregister_from_load_balancers_on_destroy in the provider features block", serverInstanceNo, strings.Join(names, ", "))
+	}
+
+	for _, lb := range lbs {
+		if err := deregisterServerFromLoadBalancer(client, lb, serverInstanceNo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateInstanceTags deletes the tags removed from the set and creates the tags added to it.
+// A tag whose value changed is deleted and created again.
+func updateInstanceTags(client *NcloudAPIClient, instanceNo string, o, n *schema.Set) error {
+	if remove := o.Difference(n); remove.Len() > 0 {
+		tagList, err := structure.ExpandTagListParams(remove.List())
+		if err != nil {
+			return err
+		}
+		reqParams := &server.DeleteInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(instanceNo)},
+			InstanceTagList: tagList,
+		}
+		logCommonRequest("DeleteInstanceTags", reqParams)
+		resp, err := client.server.V2Api.DeleteInstanceTags(reqParams)
+		if err != nil {
+			logErrorResponse("DeleteInstanceTags", err, reqParams)
+			return err
+		}
+		logCommonResponse("DeleteInstanceTags", GetCommonResponse(resp))
+	}
+
+	if add := n.Difference(o); add.Len() > 0 {
+		tagList, err := structure.ExpandTagListParams(add.List())
+		if err != nil {
+			return err
+		}
+		reqParams := &server.CreateInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(instanceNo)},
+			InstanceTagList: tagList,
+		}
+		logCommonRequest("CreateInstanceTags", reqParams)
+		resp, err := client.server.V2Api.CreateInstanceTags(reqParams)
+		if err != nil {
+			logErrorResponse("CreateInstanceTags", err, reqParams)
+			return err
+		}
+		logCommonResponse("CreateInstanceTags", GetCommonResponse(resp))
+	}
+
+	return nil
+}
+
+// imageServerBeforeDestroy creates a member server image of the stopped server, then deletes the images created
+// for the same server name beyond the retention count.
+func imageServerBeforeDestroy(client *NcloudAPIClient, serverInstance *server.ServerInstance, retention int, timeout time.Duration) error {
+	serverName := ncloud.StringValue(serverInstance.ServerName)
+	reqParams := &server.CreateMemberServerImageRequest{
+		ServerInstanceNo:             serverInstance.ServerInstanceNo,
+		MemberServerImageName:        ncloud.String(autoImageName(serverName, time.Now())),
+		MemberServerImageDescription: ncloud.String(fmt.Sprintf("Created before destroying server %s", serverName)),
+	}
+	if _, err := createMemberServerImage(client, reqParams, timeout); err != nil {
+		return err
+	}
+
+	if retention == 0 {
+		return nil
+	}
+
+	memberServerImages, err := getMemberServerImageList(client, &server.GetMemberServerImageListRequest{})
+	if err != nil {
+		return err
+	}
+	for _, memberServerImage := range expiredAutoImages(memberServerImages, serverName, retention) {
+		log.Printf("[INFO] deleting member server image [%s] beyond auto_image_retention", ncloud.StringValue(memberServerImage.MemberServerImageName))
+		if err := deleteMemberServerImage(client, ncloud.StringValue(memberServerImage.MemberServerImageNo), timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const autoImageTimeFormat = "20060102150405"
+
+// autoImageNamePrefix truncates the server name, so the image name fits in the 30 characters allowed.
+func autoImageNamePrefix(serverName string) string {
+	if len(serverName) > 15 {
+		return serverName[:15]
+	}
+	return serverName
+}
+
+func autoImageName(serverName string, t time.Time) string {
+	return fmt.Sprintf("%s-%s", autoImageNamePrefix(serverName), t.UTC().Format(autoImageTimeFormat))
+}
+
+// expiredAutoImages returns the images created by auto_image_on_destroy for the server name, except the retention most recent ones.
+// The image name only holds a prefix of the server name, so the images are matched on the full name of their original server.
+func expiredAutoImages(memberServerImages []*server.MemberServerImage, serverName string, retention int) []*server.MemberServerImage {
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(autoImageNamePrefix(serverName)) + `-\d{14}$`)
+
+	var images []*server.MemberServerImage
+	for _, memberServerImage := range memberServerImages {
+		if ncloud.StringValue(memberServerImage.OriginalServerName) == serverName && pattern.MatchString(ncloud.StringValue(memberServerImage.MemberServerImageName)) {
+			images = append(images, memberServerImage)
+		}
+	}
+	if len(images) <= retention {
+		return nil
+	}
+
+	// The names end with the creation time, so they sort by age.
+	sort.Slice(images, func(i, j int) bool {
+		return ncloud.StringValue(images[i].MemberServerImageName) > ncloud.StringValue(images[j].MemberServerImageName)
+	})
+	return images[retention:]
+}
+
+// isBareMetalServerProductCode reports whether the server product code is a bare metal product, e.g. SPSVRBM000000001.
+func isBareMetalServerProductCode(code string) bool {
+	return strings.HasPrefix(code, "SPSVRBM")
+}
+
+// serverProductDiskDetailType returns the disk detail type of the base block storage of a server product,
+// e.g. SSD for SPSVRSSD00000003 and HDD for SPSVRSTAND000004.
+func serverProductDiskDetailType(code string) string {
+	if strings.Contains(code, ServerDiskDetailTypeSSD) {
+		return ServerDiskDetailTypeSSD
+	}
+	return ServerDiskDetailTypeHDD
+}
+
+// getServerProductCodeByDiskDetailType returns the minimum server product of the image with the given disk detail type.
+func getServerProductCodeByDiskDetailType(client *NcloudAPIClient, serverImageProductCode string, zoneNo *string, diskDetailType string) (string, error) {
+	reqParams := &server.GetServerProductListRequest{
+		ServerImageProductCode: ncloud.String(serverImageProductCode),
+		ZoneNo:                 zoneNo,
+	}
+
+	logCommonRequest("GetServerProductList", reqParams)
+	resp, err := client.server.V2Api.GetServerProductList(reqParams)
+	if err != nil {
+		logErrorResponse("GetServerProductList", err, reqParams)
+		return "", err
+	}
+	logCommonResponse("GetServerProductList", GetCommonResponse(resp))
+
+	product := findServerProductByDiskDetailType(resp.ProductList, diskDetailType)
+	if product == nil {
+		return "", fmt.Errorf("no server product with a %s base block storage for server image product code %s", diskDetailType, serverImageProductCode)
+	}
+	return ncloud.StringValue(product.ProductCode), nil
+}
+
+// findServerProductByDiskDetailType returns the first product with the given disk detail type.
+// getServerProductList returns the products in order of specification, so it is the minimum one.
+func findServerProductByDiskDetailType(products []*server.Product, diskDetailType string) *server.Product {
+	for _, product := range products {
+		if serverProductDiskDetailType(ncloud.StringValue(product.ProductCode)) == diskDetailType {
+			return product
+		}
+	}
+	return nil
+}
+
+// validateRaidTypeName checks a raid type name against the raid types of getRaidList.
+func validateRaidTypeName(client *NcloudAPIClient, raidTypeName string) error {
+	if raidTypeName == "" {
+		return nil
+	}
+
+	reqParams := &server.GetRaidListRequest{}
+	logCommonRequest("GetRaidList", reqParams)
+	resp, err := client.server.V2Api.GetRaidList(reqParams)
+	if err != nil {
+		logErrorResponse("GetRaidList", err, reqParams)
+		return err
+	}
+	logCommonResponse("GetRaidList", GetCommonResponse(resp))
+
+	var raidTypeNames []string
+	for _, raid := range resp.RaidList {
+		raidTypeNames = append(raidTypeNames, ncloud.StringValue(raid.RaidTypeName))
+	}
+	return validateIncludes(raidTypeNames, raidTypeName, "raid_type_name")
+}
+
 func getServerInstance(client *NcloudAPIClient, serverInstanceNo string) (*server.ServerInstance, error) {
 	reqParams := new(server.GetServerInstanceListRequest)
 	reqParams.ServerInstanceNoList = []*string{ncloud.String(serverInstanceNo)}
@@ -447,6 +1372,149 @@ func stopServerInstance(client *NcloudAPIClient, serverInstanceNo string) error
 	return nil
 }
 
+// shutdownServerInstance stops the server through the OS and waits up to timeout for it to be stopped.
+// The server API has no separate force stop, so with the `force` behavior a server that is still running after the timeout
+// is left to the termination, which powers it off.
+func shutdownServerInstance(client *NcloudAPIClient, serverInstanceNo string, behavior string, timeout string) error {
+	stopTimeout, err := time.ParseDuration(timeout)
+	if err != nil {
+		return fmt.Errorf("invalid shutdown_timeout: %s", err)
+	}
+
+	if err := stopServerInstance(client, serverInstanceNo); err != nil {
+		return err
+	}
+
+	err = waitForServerInstanceWithTimeout(client, serverInstanceNo, "NSTOP", stopTimeout)
+	if err == nil {
+		return nil
+	}
+	if behavior != ServerShutdownForce {
+		return fmt.Errorf("server instance [%s] did not stop within %s: %s. "+
+			"retry the destroy, or set shutdown_behavior = \"force\" to terminate it anyway", serverInstanceNo, timeout, err)
+	}
+
+	log.Printf("[WARN] server instance [%s] did not stop within %s, terminating it anyway", serverInstanceNo, timeout)
+	return nil
+}
+
+// waitForServerReady waits until the configured port of the server accepts TCP connections,
+// or the configured HTTP path answers with a 2xx status.
+func waitForServerReady(client *NcloudAPIClient, serverInstanceNo string, config map[string]interface{}) error {
+	timeout, err := time.ParseDuration(config["timeout"].(string))
+	if err != nil {
+		return fmt.Errorf("invalid wait_for_ready timeout: %s", err)
+	}
+
+	instance, err := getServerInstance(client, serverInstanceNo)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("server instance [%s] not found", serverInstanceNo)
+	}
+
+	host := serverInstanceAddress(instance, config["address_type"].(string))
+	if host == "" {
+		return fmt.Errorf("server instance [%s] has no %s IP to check for wait_for_ready", serverInstanceNo, config["address_type"].(string))
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(config["port"].(int)))
+	if path, ok := config["path"].(string); ok && path != "" {
+		return waitForHTTPPath(fmt.Sprintf("http://%s/%s", address, strings.TrimPrefix(path, "/")), timeout)
+	}
+	return waitForTCPPort(address, timeout)
+}
+
+// serverInstanceAddress returns the IP of the server to connect to.
+// The address type is auto (public IP, or private IP when the server has none), public or private.
+func serverInstanceAddress(instance *server.ServerInstance, addressType string) string {
+	switch addressType {
+	case "public":
+		return ncloud.StringValue(instance.PublicIp)
+	case "private":
+		return ncloud.StringValue(instance.PrivateIp)
+	default:
+		if ip := ncloud.StringValue(instance.PublicIp); ip != "" {
+			return ip
+		}
+		return ncloud.StringValue(instance.PrivateIp)
+	}
+}
+
+func waitForHTTPPath(url string, timeout time.Duration) error {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := httpClient.Get(url)
+		if err != nil {
+			log.Printf("[DEBUG] Wait for %s to answer: %s", url, err)
+			return resource.RetryableError(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			log.Printf("[DEBUG] Wait for %s to answer with a 2xx status: %s", url, resp.Status)
+			return resource.RetryableError(fmt.Errorf("%s answered with %s", url, resp.Status))
+		}
+		return nil
+	})
+}
+
+func waitForTCPPort(address string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		conn, err := net.DialTimeout("tcp", address, 5*time.Second)
+		if err != nil {
+			log.Printf("[DEBUG] Wait for %s to accept connections: %s", address, err)
+			return resource.RetryableError(err)
+		}
+		conn.Close()
+		return nil
+	})
+}
+
+func startServerInstance(client *NcloudAPIClient, serverInstanceNo string) error {
+	reqParams := &server.StartServerInstancesRequest{
+		ServerInstanceNoList: []*string{ncloud.String(serverInstanceNo)},
+	}
+	logCommonRequest("StartServerInstances", reqParams)
+	resp, err := client.server.V2Api.StartServerInstances(reqParams)
+	if err != nil {
+		logErrorResponse("StartServerInstances", err, reqParams)
+		return err
+	}
+	logCommonResponse("StartServerInstances", GetCommonResponse(resp))
+
+	return nil
+}
+
+// changeServerState starts or stops the server and waits until it reaches the requested power state.
+func changeServerState(client *NcloudAPIClient, serverInstanceNo string, state string) error {
+	switch state {
+	case ServerStateRunning:
+		if err := startServerInstance(client, serverInstanceNo); err != nil {
+			return err
+		}
+		return waitForServerInstance(client, serverInstanceNo, "RUN")
+	case ServerStateStopped:
+		if err := stopServerInstance(client, serverInstanceNo); err != nil {
+			return err
+		}
+		return waitForServerInstance(client, serverInstanceNo, "NSTOP")
+	}
+	return fmt.Errorf("unsupported server state: %s", state)
+}
+
+// serverStateFromStatusCode maps the server instance status code to the `state` argument.
+// Transitional statuses (INIT, CREAT, ...) have no state and return "".
+func serverStateFromStatusCode(code string) string {
+	switch code {
+	case "RUN":
+		return ServerStateRunning
+	case "NSTOP":
+		return ServerStateStopped
+	}
+	return ""
+}
+
 func terminateServerInstance(client *NcloudAPIClient, serverInstanceNo string) error {
 	reqParams := &server.TerminateServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(serverInstanceNo)},
@@ -476,6 +1544,10 @@ func terminateServerInstance(client *NcloudAPIClient, serverInstanceNo string) e
 }
 
 func waitForServerInstance(client *NcloudAPIClient, instanceId string, status string) error {
+	return waitForServerInstanceWithTimeout(client, instanceId, status, DefaultCreateTimeout)
+}
+
+func waitForServerInstanceWithTimeout(client *NcloudAPIClient, instanceId string, status string, timeout time.Duration) error {
 
 	c1 := make(chan error, 1)
 
@@ -499,7 +1571,7 @@ func waitForServerInstance(client *NcloudAPIClient, instanceId string, status st
 	select {
 	case res := <-c1:
 		return res
-	case <-time.After(DefaultCreateTimeout):
+	case <-time.After(timeout):
 		return fmt.Errorf("TIMEOUT : Wait to server instance  (%s)", instanceId)
 	}
 }
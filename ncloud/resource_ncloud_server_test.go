@@ -2,12 +2,20 @@ package ncloud
 
 import (
 	"fmt"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestAccResourceNcloudServerBasic(t *testing.T) {
@@ -49,6 +57,137 @@ func TestAccResourceNcloudServerBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServerRootPassword(t *testing.T) {
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ncloud_server.server",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerRootPasswordConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"ncloud_server.server",
+						"root_password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServerTags(t *testing.T) {
+	var before server.ServerInstance
+	var after server.ServerInstance
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ncloud_server.server",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerTagsConfig(testServerName, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(
+						"ncloud_server.server", &before),
+					resource.TestCheckResourceAttr(
+						"ncloud_server.server",
+						"tag_list.#",
+						"1"),
+				),
+			},
+			{
+				Config: testAccServerTagsConfig(testServerName, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(
+						"ncloud_server.server", &after),
+					testAccCheckInstanceNotRecreated(
+						t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"ncloud_server.server",
+						"tag_list.#",
+						"1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServerPreflightValidation(t *testing.T) {
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerPreflightValidationConfig(testServerName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("server_product_code SPSVRNOTEXIST000 is not available"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServerState(t *testing.T) {
+	var before server.ServerInstance
+	var after server.ServerInstance
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ncloud_server.server",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerStateConfig(testServerName, ServerStateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(
+						"ncloud_server.server", &before),
+					resource.TestCheckResourceAttr(
+						"ncloud_server.server",
+						"state",
+						ServerStateStopped),
+				),
+			},
+			{
+				Config: testAccServerStateConfig(testServerName, ServerStateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(
+						"ncloud_server.server", &after),
+					testAccCheckInstanceNotRecreated(
+						t, &before, &after),
+					resource.TestCheckResourceAttr(
+						"ncloud_server.server",
+						"state",
+						ServerStateRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestServerStateFromStatusCode(t *testing.T) {
+	cases := map[string]string{
+		"RUN":   ServerStateRunning,
+		"NSTOP": ServerStateStopped,
+		"INIT":  "",
+		"CREAT": "",
+		"":      "",
+	}
+
+	for code, expected := range cases {
+		if state := serverStateFromStatusCode(code); state != expected {
+			t.Fatalf("expected state %q for status %q, got %q", expected, code, state)
+		}
+	}
+}
+
 // TODO: Fix Unable to change server error
 // "returnCode": "25013",
 // "returnMessage": "Unable to change server specification since (other) user is either operating the target server or due to an error in target server. Please check the server status. "
@@ -210,6 +349,74 @@ resource "ncloud_server" "server" {
 `, testServerName, testServerName)
 }
 
+func testAccServerRootPasswordConfig(testServerName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%s-key"
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"private_key" = "${ncloud_login_key.loginkey.private_key}"
+}
+`, testServerName, testServerName)
+}
+
+func testAccServerStateConfig(testServerName string, state string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%s-key"
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"state" = "%s"
+}
+`, testServerName, testServerName, state)
+}
+
+func testAccServerTagsConfig(testServerName string, tagValue string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%s-key"
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+
+	"tag_list" = [
+		{
+			"tag_key"   = "env"
+			"tag_value" = "%s"
+		},
+	]
+}
+`, testServerName, testServerName, tagValue)
+}
+
+func testAccServerPreflightValidationConfig(testServerName string) string {
+	return fmt.Sprintf(`
+provider "ncloud" {
+	"preflight_validation" = true
+}
+
+resource "ncloud_server" "server" {
+	"server_name" = "%s"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRNOTEXIST000"
+}
+`, testServerName)
+}
+
 func testAccInstanceChangeSpecConfig(testServerName string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
@@ -254,3 +461,307 @@ resource "ncloud_server" "server" {
 }
 `, testServerName, testServerName)
 }
+
+func TestUserDataHashSum(t *testing.T) {
+	script := "#!/bin/sh\necho hello"
+
+	if hash := userDataHashSum(script); hash != "12fa244b5fce655cad7e956e74e3248840916307" {
+		t.Fatalf("unexpected hash of user data: %s", hash)
+	}
+	if userDataHashSum(script) == userDataHashSum(script+"\n") {
+		t.Fatalf("expected different scripts to have different hashes")
+	}
+	if hash := userDataHashSum(""); hash != "" {
+		t.Fatalf("expected empty hash for empty user data, got %s", hash)
+	}
+}
+
+func TestUserDataDiffAfterApply(t *testing.T) {
+	r := resourceNcloudServer()
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"server_image_product_code": "SPSW0LINUX000032",
+		"user_data":                 "#!/bin/sh\necho hi",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := terraform.NewResourceConfig(raw)
+
+	diff, err := r.Diff(nil, c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Apply without the API: Create only assigns the ID, so the state holds what the schema stores.
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId("100")
+		return nil
+	}
+	state, err := r.Apply(nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := state.Attributes["user_data"]; v != userDataHashSum("#!/bin/sh\necho hi") {
+		t.Fatalf("expected the hash of user_data in the state, but was %q", v)
+	}
+
+	diff, err = r.Diff(state, c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The computed attributes the fake Create leaves unset are planned again, but user_data must not be.
+	if attr, ok := diff.Attributes["user_data"]; ok {
+		t.Fatalf("expected no diff of user_data after apply, but was %#v", attr)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected no replacement after apply, but was %#v", diff.Attributes)
+	}
+}
+
+func TestServerNameRandomSuffixDiff(t *testing.T) {
+	cases := []struct {
+		stateSuffix  string
+		configSuffix bool
+	}{
+		{"false", true},
+		{"true", false},
+	}
+
+	for _, c := range cases {
+		state := &terraform.InstanceState{
+			ID: "100",
+			Attributes: map[string]string{
+				"server_image_product_code": "SPSW0LINUX000032",
+				"server_name":               "web",
+				"server_name_random_suffix": c.stateSuffix,
+			},
+		}
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"server_image_product_code": "SPSW0LINUX000032",
+			"server_name":               "web",
+			"server_name_random_suffix": c.configSuffix,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := resourceNcloudServer().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("expected no error turning server_name_random_suffix to %t, but was %s", c.configSuffix, err)
+		}
+		if diff == nil || !diff.RequiresNew() {
+			t.Fatalf("expected turning server_name_random_suffix to %t to replace the server, but was %#v", c.configSuffix, diff)
+		}
+	}
+}
+
+func TestBareMetalServerRespecDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "100",
+		Attributes: map[string]string{
+			"server_image_product_code": "SPSW0LINUX000032",
+			"server_product_code":       "SPSVRBM000000001",
+			"server_name":               "web",
+			"server_description":        "web server",
+		},
+	}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"server_image_product_code": "SPSW0LINUX000032",
+		"server_product_code":       "SPSVRBM000000002",
+		"server_name":               "web-bm",
+		"server_description":        "bare metal web server",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := resourceNcloudServer().Diff(state, terraform.NewResourceConfig(raw), nil)
+	if err != nil {
+		t.Fatalf("expected no error renaming a bare metal server whose specification changes, but was %s", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected changing the specification of a bare metal server to replace it, but was %#v", diff)
+	}
+
+	// The checks after the replacement still apply.
+	raw, err = config.NewRawConfig(map[string]interface{}{
+		"server_image_product_code": "SPSW0LINUX000032",
+		"server_product_code":       "SPSVRBM000000002",
+		"server_name":               "web",
+		"tag_list": []interface{}{
+			map[string]interface{}{"tag_key": "metadata:team", "tag_value": "infra"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resourceNcloudServer().Diff(state, terraform.NewResourceConfig(raw), nil); err == nil {
+		t.Fatal("expected an error for a tag_key reserved for metadata on a bare metal server whose specification changes")
+	}
+}
+
+func TestWaitForTCPPort(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+
+	if err := waitForTCPPort(address, 5*time.Second); err != nil {
+		t.Fatalf("expected open port to be ready: %s", err)
+	}
+
+	listener.Close()
+	if err := waitForTCPPort(address, 1*time.Second); err == nil {
+		t.Fatalf("expected closed port to time out")
+	}
+}
+
+func TestIsBareMetalServerProductCode(t *testing.T) {
+	cases := map[string]bool{
+		"SPSVRBM000000001": true,
+		"SPSVRSTAND000004": false,
+		"SPSWBMLINUX00001": false,
+		"":                 false,
+	}
+
+	for code, expected := range cases {
+		if isBareMetalServerProductCode(code) != expected {
+			t.Fatalf("expected isBareMetalServerProductCode(%q) to be %t", code, expected)
+		}
+	}
+}
+
+func TestServerProductDiskDetailType(t *testing.T) {
+	cases := map[string]string{
+		"SPSVRSSD00000003": ServerDiskDetailTypeSSD,
+		"SPSVRSTAND000004": ServerDiskDetailTypeHDD,
+		"SPSVRBM000000001": ServerDiskDetailTypeHDD,
+	}
+
+	for code, expected := range cases {
+		if r := serverProductDiskDetailType(code); r != expected {
+			t.Fatalf("expected serverProductDiskDetailType(%q) to be %s, but was %s", code, expected, r)
+		}
+	}
+}
+
+func TestFindServerProductByDiskDetailType(t *testing.T) {
+	products := []*server.Product{
+		{ProductCode: ncloud.String("SPSVRSTAND000056")},
+		{ProductCode: ncloud.String("SPSVRSSD00000003")},
+		{ProductCode: ncloud.String("SPSVRSSD00000005")},
+	}
+
+	if r := findServerProductByDiskDetailType(products, ServerDiskDetailTypeSSD); r != products[1] {
+		t.Fatalf("expected the first SSD product, but was %v", r)
+	}
+	if r := findServerProductByDiskDetailType(products[1:], ServerDiskDetailTypeHDD); r != nil {
+		t.Fatalf("expected no HDD product, but was %v", r)
+	}
+}
+
+func TestWaitForHTTPPath(t *testing.T) {
+	// The handler runs on the goroutines of the test server, so the flag is accessed atomically.
+	var ready int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || atomic.LoadInt32(&ready) == 0 {
+			atomic.StoreInt32(&ready, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	if err := waitForHTTPPath(ts.URL+"/health", 5*time.Second); err != nil {
+		t.Fatalf("expected health check to pass: %s", err)
+	}
+	if err := waitForHTTPPath(ts.URL+"/missing", 1*time.Second); err == nil {
+		t.Fatalf("expected failing health check to time out")
+	}
+}
+
+func TestFindMemberServerImageNoByName(t *testing.T) {
+	images := []*server.MemberServerImage{
+		{MemberServerImageNo: ncloud.String("1"), MemberServerImageName: ncloud.String("golden-web")},
+		{MemberServerImageNo: ncloud.String("2"), MemberServerImageName: ncloud.String("golden-db")},
+		{MemberServerImageNo: ncloud.String("3"), MemberServerImageName: ncloud.String("golden-db")},
+	}
+
+	no, err := findMemberServerImageNoByName(images, "golden-web")
+	if err != nil || no != "1" {
+		t.Fatalf("expected member server image no 1, got %q (%v)", no, err)
+	}
+	if _, err := findMemberServerImageNoByName(images, "golden-db"); err == nil {
+		t.Fatalf("expected error for ambiguous member server image name")
+	}
+	if _, err := findMemberServerImageNoByName(images, "missing"); err == nil {
+		t.Fatalf("expected error for unknown member server image name")
+	}
+}
+
+func TestServerNameSuffix(t *testing.T) {
+	suffixed, err := appendServerNameSuffix("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suffixed == "web" || !serverNameSuffixPattern.MatchString(suffixed) {
+		t.Fatalf("expected a random suffix, got %q", suffixed)
+	}
+	if trimmed := trimServerNameSuffix(suffixed); trimmed != "web" {
+		t.Fatalf("expected %q, got %q", "web", trimmed)
+	}
+	if trimmed := trimServerNameSuffix("web-server"); trimmed != "web-server" {
+		t.Fatalf("expected name without suffix to be kept, got %q", trimmed)
+	}
+	if _, err := appendServerNameSuffix("a-server-name-of-26-chars1"); err == nil {
+		t.Fatalf("expected error for a server name too long to suffix")
+	}
+}
+
+func TestAutoImageName(t *testing.T) {
+	created := time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	if name := autoImageName("web", created); name != "web-20190304050607" {
+		t.Fatalf("expected web-20190304050607, got %s", name)
+	}
+	if name := autoImageName("a-very-long-server-name", created); name != "a-very-long-ser-20190304050607" || len(name) > 30 {
+		t.Fatalf("expected a truncated name of at most 30 characters, got %s", name)
+	}
+}
+
+func TestExpiredAutoImages(t *testing.T) {
+	images := []*server.MemberServerImage{
+		{MemberServerImageNo: ncloud.String("1"), MemberServerImageName: ncloud.String("web-20190101000000"), OriginalServerName: ncloud.String("web")},
+		{MemberServerImageNo: ncloud.String("2"), MemberServerImageName: ncloud.String("web-20190301000000"), OriginalServerName: ncloud.String("web")},
+		{MemberServerImageNo: ncloud.String("3"), MemberServerImageName: ncloud.String("web-20190201000000"), OriginalServerName: ncloud.String("web")},
+		{MemberServerImageNo: ncloud.String("4"), MemberServerImageName: ncloud.String("web-golden"), OriginalServerName: ncloud.String("web")},
+		{MemberServerImageNo: ncloud.String("5"), MemberServerImageName: ncloud.String("db-20190101000000"), OriginalServerName: ncloud.String("db")},
+		{MemberServerImageNo: ncloud.String("6"), MemberServerImageName: ncloud.String("web-20180101000000"), OriginalServerName: ncloud.String("web2")},
+	}
+
+	expired := expiredAutoImages(images, "web", 2)
+	if len(expired) != 1 || ncloud.StringValue(expired[0].MemberServerImageNo) != "1" {
+		t.Fatalf("expected only the oldest web image to expire, got %d images", len(expired))
+	}
+	if expired := expiredAutoImages(images, "web", 3); len(expired) != 0 {
+		t.Fatalf("expected no image to expire, got %d images", len(expired))
+	}
+}
+
+func TestExpiredAutoImagesSharedNamePrefix(t *testing.T) {
+	images := []*server.MemberServerImage{
+		{MemberServerImageNo: ncloud.String("1"), MemberServerImageName: ncloud.String("frontend-server-20190101000000"), OriginalServerName: ncloud.String("frontend-server-a")},
+		{MemberServerImageNo: ncloud.String("2"), MemberServerImageName: ncloud.String("frontend-server-20190201000000"), OriginalServerName: ncloud.String("frontend-server-b")},
+		{MemberServerImageNo: ncloud.String("3"), MemberServerImageName: ncloud.String("frontend-server-20190301000000"), OriginalServerName: ncloud.String("frontend-server-a")},
+		{MemberServerImageNo: ncloud.String("4"), MemberServerImageName: ncloud.String("frontend-server-20190401000000"), OriginalServerName: ncloud.String("frontend-server-b")},
+	}
+
+	expired := expiredAutoImages(images, "frontend-server-a", 1)
+	if len(expired) != 1 || ncloud.StringValue(expired[0].MemberServerImageNo) != "1" {
+		t.Fatalf("expected only the oldest image of frontend-server-a to expire, got %v", expired)
+	}
+	if expired := expiredAutoImages(images, "frontend-server-b", 2); len(expired) != 0 {
+		t.Fatalf("expected no image of frontend-server-b to expire, got %d images", len(expired))
+	}
+}
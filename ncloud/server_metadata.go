@@ -0,0 +1,40 @@
+package ncloud
+
+import (
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// serverMetadataTagPrefix prefixes the instance tag keys holding `metadata`.
+// Classic servers have no key/value store of their own, so each metadata entry is stored as an instance tag.
+const serverMetadataTagPrefix = "metadata:"
+
+// expandServerMetadataTags converts `metadata` to the instance tags storing it, as a `tag_list` set.
+func expandServerMetadataTags(metadata map[string]interface{}) *schema.Set {
+	tags := schema.NewSet(schema.HashResource(tagListSchemaResource), nil)
+	for k, v := range metadata {
+		tags.Add(map[string]interface{}{
+			"tag_key":   serverMetadataTagPrefix + k,
+			"tag_value": v.(string),
+		})
+	}
+	return tags
+}
+
+// splitServerMetadataTags separates the instance tags storing `metadata` from the other instance tags.
+func splitServerMetadataTags(tagList []*server.InstanceTag) ([]*server.InstanceTag, map[string]string) {
+	var tags []*server.InstanceTag
+	metadata := make(map[string]string)
+	for _, tag := range tagList {
+		key := ncloud.StringValue(tag.TagKey)
+		if strings.HasPrefix(key, serverMetadataTagPrefix) {
+			metadata[strings.TrimPrefix(key, serverMetadataTagPrefix)] = ncloud.StringValue(tag.TagValue)
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags, metadata
+}
@@ -0,0 +1,50 @@
+package ncloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/terraform-provider-ncloud/internal/structure"
+)
+
+func TestExpandServerMetadataTags(t *testing.T) {
+	if tags := expandServerMetadataTags(nil); tags.Len() != 0 {
+		t.Fatalf("expected no tags without metadata, but was %v", tags.List())
+	}
+
+	tags := expandServerMetadataTags(map[string]interface{}{"team": "infra", "env": "dev"})
+	tagList, err := structure.ExpandTagListParams(tags.List())
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual := make(map[string]string)
+	for _, tag := range tagList {
+		actual[ncloud.StringValue(tag.TagKey)] = ncloud.StringValue(tag.TagValue)
+	}
+	expected := map[string]string{"metadata:team": "infra", "metadata:env": "dev"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected tags %v, but was %v", expected, actual)
+	}
+}
+
+func TestSplitServerMetadataTags(t *testing.T) {
+	tags, metadata := splitServerMetadataTags([]*server.InstanceTag{
+		{TagKey: ncloud.String("env"), TagValue: ncloud.String("prod")},
+		{TagKey: ncloud.String("metadata:team"), TagValue: ncloud.String("infra")},
+		{TagKey: ncloud.String("metadata:"), TagValue: ncloud.String("empty")},
+	})
+
+	if len(tags) != 1 || ncloud.StringValue(tags[0].TagKey) != "env" {
+		t.Fatalf("expected only tag env to be kept, but was %v", tags)
+	}
+	expected := map[string]string{"team": "infra", "": "empty"}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Fatalf("expected metadata %v, but was %v", expected, metadata)
+	}
+
+	if _, metadata := splitServerMetadataTags(nil); len(metadata) != 0 {
+		t.Fatalf("expected no metadata without tags, but was %v", metadata)
+	}
+}
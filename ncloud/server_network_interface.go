@@ -0,0 +1,314 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var networkInterfaceSchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"private_subnet_instance_no": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Private subnet instance number to create the network interface in",
+		},
+		"network_interface_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Network interface name. Identifies the network interface of the server.",
+		},
+		"network_interface_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "IP address of the network interface in the private subnet",
+		},
+		"network_interface_description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Network interface description",
+		},
+		"network_interface_no": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Network interface number",
+		},
+	},
+}
+
+// createServerNetworkInterfaces creates the given `network_interface` blocks attached to the server.
+func createServerNetworkInterfaces(client *NcloudAPIClient, serverInstanceNo string, zoneNo string, networkInterfaces []interface{}) error {
+	for _, v := range networkInterfaces {
+		m := v.(map[string]interface{})
+		reqParams := &server.CreateNetworkInterfaceRequest{
+			PrivateSubnetInstanceNo:     ncloud.String(m["private_subnet_instance_no"].(string)),
+			NetworkInterfaceName:        ncloud.String(m["network_interface_name"].(string)),
+			NetworkInterfaceIp:          ncloud.String(m["network_interface_ip"].(string)),
+			NetworkInterfaceDescription: ncloud.String(m["network_interface_description"].(string)),
+			ZoneNo:                      ncloud.String(zoneNo),
+			ServerInstanceNo:            ncloud.String(serverInstanceNo),
+		}
+
+		logCommonRequest("CreateNetworkInterface", reqParams)
+		resp, err := client.server.V2Api.CreateNetworkInterface(reqParams)
+		if err != nil {
+			logErrorResponse("CreateNetworkInterface", err, reqParams)
+			return err
+		}
+		logCommonResponse("CreateNetworkInterface", GetCommonResponse(resp))
+
+		// CreateNetworkInterface does not return the network interface, so it is looked up by name.
+		err = waitForNetworkInterface(client, zoneNo, m["network_interface_name"].(string), func(nic *server.NetworkInterface) bool {
+			return nic != nil && ncloud.StringValue(nic.ServerInstanceNo) == serverInstanceNo
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteServerNetworkInterfaces detaches the given `network_interface` blocks from the server and deletes them.
+func deleteServerNetworkInterfaces(client *NcloudAPIClient, serverInstanceNo string, zoneNo string, networkInterfaces []interface{}) error {
+	for _, v := range networkInterfaces {
+		m := v.(map[string]interface{})
+		name := m["network_interface_name"].(string)
+
+		nic, err := getNetworkInterfaceByName(client, zoneNo, name)
+		if err != nil {
+			return err
+		}
+		if nic == nil {
+			continue
+		}
+
+		if ncloud.StringValue(nic.ServerInstanceNo) == serverInstanceNo {
+			reqParams := &server.DetachNetworkInterfaceRequest{
+				NetworkInterfaceNo: nic.NetworkInterfaceNo,
+				ServerInstanceNo:   ncloud.String(serverInstanceNo),
+			}
+			logCommonRequest("DetachNetworkInterface", reqParams)
+			resp, err := client.server.V2Api.DetachNetworkInterface(reqParams)
+			if err != nil {
+				logErrorResponse("DetachNetworkInterface", err, reqParams)
+				return err
+			}
+			logCommonResponse("DetachNetworkInterface", GetCommonResponse(resp))
+
+			err = waitForNetworkInterface(client, zoneNo, name, func(nic *server.NetworkInterface) bool {
+				return nic == nil || ncloud.StringValue(nic.ServerInstanceNo) == ""
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		reqParams := &server.DeleteNetworkInterfaceRequest{
+			NetworkInterfaceNo: nic.NetworkInterfaceNo,
+		}
+		logCommonRequest("DeleteNetworkInterface", reqParams)
+		resp, err := client.server.V2Api.DeleteNetworkInterface(reqParams)
+		if err != nil {
+			logErrorResponse("DeleteNetworkInterface", err, reqParams)
+			return err
+		}
+		logCommonResponse("DeleteNetworkInterface", GetCommonResponse(resp))
+
+		err = waitForNetworkInterface(client, zoneNo, name, func(nic *server.NetworkInterface) bool {
+			return nic == nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getNetworkInterfaceList(client *NcloudAPIClient, zoneNo string) ([]*server.NetworkInterface, error) {
+	reqParams := &server.GetNetworkInterfaceListRequest{
+		ZoneNo: ncloud.String(zoneNo),
+	}
+
+	logCommonRequest("GetNetworkInterfaceList", reqParams)
+	resp, err := client.server.V2Api.GetNetworkInterfaceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetNetworkInterfaceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetNetworkInterfaceList", GetCommonResponse(resp))
+
+	return resp.NetworkInterfaceList, nil
+}
+
+func getNetworkInterfaceByName(client *NcloudAPIClient, zoneNo string, name string) (*server.NetworkInterface, error) {
+	nics, err := getNetworkInterfaceList(client, zoneNo)
+	if err != nil {
+		return nil, err
+	}
+	for _, nic := range nics {
+		if ncloud.StringValue(nic.NetworkInterfaceName) == name {
+			return nic, nil
+		}
+	}
+	return nil, nil
+}
+
+// getServerNetworkInterfaces returns the network interfaces attached to the server.
+func getServerNetworkInterfaces(client *NcloudAPIClient, serverInstanceNo string, zoneNo string) ([]*server.NetworkInterface, error) {
+	nics, err := getNetworkInterfaceList(client, zoneNo)
+	if err != nil {
+		return nil, err
+	}
+
+	var attached []*server.NetworkInterface
+	for _, nic := range nics {
+		if ncloud.StringValue(nic.ServerInstanceNo) == serverInstanceNo {
+			attached = append(attached, nic)
+		}
+	}
+	return attached, nil
+}
+
+func waitForNetworkInterface(client *NcloudAPIClient, zoneNo string, name string, done func(*server.NetworkInterface) bool) error {
+	c1 := make(chan error, 1)
+
+	go func() {
+		for {
+			nic, err := getNetworkInterfaceByName(client, zoneNo, name)
+			if err != nil {
+				c1 <- err
+				return
+			}
+			if done(nic) {
+				c1 <- nil
+				return
+			}
+			log.Printf("[DEBUG] Wait network interface [%s]", name)
+			time.Sleep(time.Second * 3)
+		}
+	}()
+
+	select {
+	case res := <-c1:
+		return res
+	case <-time.After(DefaultTimeout):
+		return fmt.Errorf("TIMEOUT : Wait to network interface (%s)", name)
+	}
+}
+
+// diffNetworkInterfaces returns the `network_interface` blocks to delete and to create to move from o to n.
+// A block whose arguments changed is deleted and created again.
+func diffNetworkInterfaces(o, n []interface{}) ([]interface{}, []interface{}) {
+	var remove, add []interface{}
+	for _, v := range o {
+		if !containsNetworkInterface(n, v.(map[string]interface{})) {
+			remove = append(remove, v)
+		}
+	}
+	for _, v := range n {
+		if !containsNetworkInterface(o, v.(map[string]interface{})) {
+			add = append(add, v)
+		}
+	}
+	return remove, add
+}
+
+func containsNetworkInterface(list []interface{}, m map[string]interface{}) bool {
+	for _, v := range list {
+		if reflect.DeepEqual(networkInterfaceArguments(v.(map[string]interface{})), networkInterfaceArguments(m)) {
+			return true
+		}
+	}
+	return false
+}
+
+func networkInterfaceArguments(m map[string]interface{}) map[string]interface{} {
+	args := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != "network_interface_no" {
+			args[k] = v
+		}
+	}
+	return args
+}
+
+// flattenServerNetworkInterfaces converts the network interfaces attached to the server to `network_interface` blocks,
+// in the order of the configured blocks. The network interfaces not in the configuration are returned separately,
+// as `unmanaged_network_interfaces`: their subnet is unknown, and keeping them out of `network_interface` avoids recreating them.
+func flattenServerNetworkInterfaces(configured []interface{}, nics []*server.NetworkInterface) ([]map[string]interface{}, []map[string]interface{}) {
+	var managed, unmanaged []map[string]interface{}
+	used := make(map[int]bool, len(nics))
+
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		for i, nic := range nics {
+			if !used[i] && ncloud.StringValue(nic.NetworkInterfaceName) == m["network_interface_name"] {
+				used[i] = true
+				// The network interface list does not return the subnet, so it is kept from the configuration.
+				managed = append(managed, flattenServerNetworkInterface(nic, m["private_subnet_instance_no"].(string)))
+				break
+			}
+		}
+	}
+	for i, nic := range nics {
+		if !used[i] {
+			unmanaged = append(unmanaged, map[string]interface{}{
+				"network_interface_no":          ncloud.StringValue(nic.NetworkInterfaceNo),
+				"network_interface_name":        ncloud.StringValue(nic.NetworkInterfaceName),
+				"network_interface_ip":          ncloud.StringValue(nic.NetworkInterfaceIp),
+				"network_interface_description": ncloud.StringValue(nic.NetworkInterfaceDescription),
+			})
+		}
+	}
+
+	return managed, unmanaged
+}
+
+// importServerNetworkInterfaces converts the network interfaces of an imported server to `network_interface` blocks.
+// The network interface list does not return the subnet, so it is found by the private subnet whose CIDR holds the IP.
+// Network interfaces outside of every private subnet are left out.
+func importServerNetworkInterfaces(nics []*server.NetworkInterface, subnets []*server.PrivateSubnetInstance) []map[string]interface{} {
+	var s []map[string]interface{}
+	for _, nic := range nics {
+		ip := net.ParseIP(ncloud.StringValue(nic.NetworkInterfaceIp))
+		for _, subnet := range subnets {
+			_, cidr, err := net.ParseCIDR(ncloud.StringValue(subnet.Subnet))
+			if err == nil && ip != nil && cidr.Contains(ip) {
+				s = append(s, flattenServerNetworkInterface(nic, ncloud.StringValue(subnet.PrivateSubnetInstanceNo)))
+				break
+			}
+		}
+	}
+	return s
+}
+
+func flattenServerNetworkInterface(nic *server.NetworkInterface, privateSubnetInstanceNo string) map[string]interface{} {
+	return map[string]interface{}{
+		"private_subnet_instance_no":    privateSubnetInstanceNo,
+		"network_interface_name":        ncloud.StringValue(nic.NetworkInterfaceName),
+		"network_interface_ip":          ncloud.StringValue(nic.NetworkInterfaceIp),
+		"network_interface_description": ncloud.StringValue(nic.NetworkInterfaceDescription),
+		"network_interface_no":          ncloud.StringValue(nic.NetworkInterfaceNo),
+	}
+}
+
+func getPrivateSubnetInstanceList(client *NcloudAPIClient, regionNo *string) ([]*server.PrivateSubnetInstance, error) {
+	reqParams := &server.GetPrivateSubnetInstanceListRequest{RegionNo: regionNo}
+
+	logCommonRequest("GetPrivateSubnetInstanceList", reqParams)
+
+	resp, err := client.server.V2Api.GetPrivateSubnetInstanceList(reqParams)
+	if err != nil {
+		logErrorResponse("GetPrivateSubnetInstanceList", err, reqParams)
+		return nil, err
+	}
+	logCommonResponse("GetPrivateSubnetInstanceList", GetCommonResponse(resp))
+
+	return resp.PrivateSubnetInstanceList, nil
+}
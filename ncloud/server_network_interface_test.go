@@ -0,0 +1,127 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+func testNetworkInterfaceBlock(name, ip string) map[string]interface{} {
+	return map[string]interface{}{
+		"private_subnet_instance_no":    "1000",
+		"network_interface_name":        name,
+		"network_interface_ip":          ip,
+		"network_interface_description": "",
+		"network_interface_no":          "",
+	}
+}
+
+func TestDiffNetworkInterfaces(t *testing.T) {
+	eth1 := testNetworkInterfaceBlock("eth1", "10.0.0.11")
+	eth2 := testNetworkInterfaceBlock("eth2", "10.0.0.12")
+	eth2Changed := testNetworkInterfaceBlock("eth2", "10.0.0.22")
+	eth3 := testNetworkInterfaceBlock("eth3", "10.0.0.13")
+
+	// network_interface_no is computed and must not cause a replacement.
+	eth1WithNo := testNetworkInterfaceBlock("eth1", "10.0.0.11")
+	eth1WithNo["network_interface_no"] = "500"
+
+	remove, add := diffNetworkInterfaces(
+		[]interface{}{eth1WithNo, eth2},
+		[]interface{}{eth1, eth2Changed, eth3},
+	)
+
+	if len(remove) != 1 || remove[0].(map[string]interface{})["network_interface_ip"] != "10.0.0.12" {
+		t.Fatalf("expected eth2 to be removed, but was %v", remove)
+	}
+	if len(add) != 2 || add[0].(map[string]interface{})["network_interface_ip"] != "10.0.0.22" || add[1].(map[string]interface{})["network_interface_name"] != "eth3" {
+		t.Fatalf("expected changed eth2 and eth3 to be added, but was %v", add)
+	}
+}
+
+func TestFlattenServerNetworkInterfaces(t *testing.T) {
+	configured := []interface{}{
+		testNetworkInterfaceBlock("eth1", "10.0.0.11"),
+		testNetworkInterfaceBlock("eth2", "10.0.0.12"),
+	}
+	nics := []*server.NetworkInterface{
+		{
+			NetworkInterfaceNo:   ncloud.String("502"),
+			NetworkInterfaceName: ncloud.String("eth9"),
+			NetworkInterfaceIp:   ncloud.String("10.0.0.19"),
+		},
+		{
+			NetworkInterfaceNo:   ncloud.String("501"),
+			NetworkInterfaceName: ncloud.String("eth2"),
+			NetworkInterfaceIp:   ncloud.String("10.0.0.12"),
+		},
+	}
+
+	result, unmanaged := flattenServerNetworkInterfaces(configured, nics)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["network_interface_name"] != "eth2" || r["network_interface_no"] != "501" {
+		t.Fatalf("expected configured eth2, but was %v", r)
+	}
+	if r["private_subnet_instance_no"] != "1000" {
+		t.Fatalf("expected private_subnet_instance_no to be kept from the configuration, but was %s", r["private_subnet_instance_no"])
+	}
+
+	if len(unmanaged) != 1 || unmanaged[0]["network_interface_name"] != "eth9" || unmanaged[0]["network_interface_no"] != "502" {
+		t.Fatalf("expected eth9 to be unmanaged, but was %v", unmanaged)
+	}
+}
+
+func TestFlattenServerNetworkInterfacesImported(t *testing.T) {
+	nics := []*server.NetworkInterface{
+		{
+			NetworkInterfaceNo:   ncloud.String("501"),
+			NetworkInterfaceName: ncloud.String("eth1"),
+			NetworkInterfaceIp:   ncloud.String("10.0.1.11"),
+		},
+		{
+			NetworkInterfaceNo:   ncloud.String("502"),
+			NetworkInterfaceName: ncloud.String("eth2"),
+			NetworkInterfaceIp:   ncloud.String("10.0.2.12"),
+		},
+		{
+			NetworkInterfaceNo:   ncloud.String("503"),
+			NetworkInterfaceName: ncloud.String("eth3"),
+			NetworkInterfaceIp:   ncloud.String("192.168.0.13"),
+		},
+	}
+	subnets := []*server.PrivateSubnetInstance{
+		{PrivateSubnetInstanceNo: ncloud.String("1001"), Subnet: ncloud.String("10.0.1.0/24")},
+		{PrivateSubnetInstanceNo: ncloud.String("1002"), Subnet: ncloud.String("10.0.2.0/24")},
+	}
+
+	imported := importServerNetworkInterfaces(nics, subnets)
+
+	if len(imported) != 2 {
+		t.Fatalf("expected the network interfaces in a private subnet to be imported, but was %v", imported)
+	}
+	if imported[0]["network_interface_no"] != "501" || imported[0]["private_subnet_instance_no"] != "1001" {
+		t.Fatalf("expected eth1 in private subnet 1001, but was %v", imported[0])
+	}
+	if imported[1]["network_interface_no"] != "502" || imported[1]["private_subnet_instance_no"] != "1002" {
+		t.Fatalf("expected eth2 in private subnet 1002, but was %v", imported[1])
+	}
+
+	// The next refresh keeps the imported network interfaces, so a matching configuration plans no change.
+	configured := make([]interface{}, 0, len(imported))
+	for _, m := range imported {
+		configured = append(configured, m)
+	}
+	result, unmanaged := flattenServerNetworkInterfaces(configured, nics)
+	if len(result) != 2 || result[0]["private_subnet_instance_no"] != "1001" || result[1]["private_subnet_instance_no"] != "1002" {
+		t.Fatalf("expected the imported network interfaces to be kept, but was %v", result)
+	}
+	if len(unmanaged) != 1 || unmanaged[0]["network_interface_name"] != "eth3" {
+		t.Fatalf("expected eth3 outside of the private subnets to be unmanaged, but was %v", unmanaged)
+	}
+}
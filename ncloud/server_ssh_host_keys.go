@@ -0,0 +1,135 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+// sshHostKeyAlgorithms are the host key algorithms asked from the server, one handshake each.
+var sshHostKeyAlgorithms = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoRSA,
+}
+
+var sshHostKeySchemaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"algorithm": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Host key algorithm, e.g. ssh-ed25519",
+		},
+		"fingerprint_sha256": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA256 fingerprint of the host key, as printed by ssh-keygen -l",
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Host key in authorized_keys format, to write to a known_hosts file",
+		},
+	},
+}
+
+// errSSHHostKeyCollected aborts the handshake once the host key is received, so no authentication is attempted.
+var errSSHHostKeyCollected = errors.New("ssh host key collected")
+
+// collectServerSSHHostKeys waits until the SSH port of the server answers and returns its host keys.
+func collectServerSSHHostKeys(client *NcloudAPIClient, serverInstanceNo string, timeout time.Duration) ([]map[string]interface{}, error) {
+	instance, err := getServerInstance(client, serverInstanceNo)
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("server instance [%s] not found", serverInstanceNo)
+	}
+
+	host := serverInstanceAddress(instance, "auto")
+	if host == "" {
+		return nil, fmt.Errorf("server instance [%s] has no IP to collect the SSH host keys from", serverInstanceNo)
+	}
+	return collectSSHHostKeys(net.JoinHostPort(host, "22"), timeout)
+}
+
+// collectSSHHostKeys returns the host keys of the SSH server at address for each algorithm it supports.
+// It retries until the server offers at least one host key.
+func collectSSHHostKeys(address string, timeout time.Duration) ([]map[string]interface{}, error) {
+	var keys []map[string]interface{}
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		keys = nil
+		var lastErr error
+		for _, algorithm := range sshHostKeyAlgorithms {
+			key, err := scanSSHHostKey(address, algorithm)
+			if err != nil {
+				log.Printf("[DEBUG] Collect %s host key of %s: %s", algorithm, address, err)
+				lastErr = err
+				continue
+			}
+			keys = append(keys, flattenSSHHostKey(key))
+		}
+		if len(keys) == 0 {
+			return resource.RetryableError(lastErr)
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// scanSSHHostKey starts an SSH handshake with the server restricted to one host key algorithm and returns the host key.
+func scanSSHHostKey(address string, algorithm string) (ssh.PublicKey, error) {
+	conn, err := net.DialTimeout("tcp", address, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	var hostKey ssh.PublicKey
+	config := &ssh.ClientConfig{
+		HostKeyAlgorithms: []string{algorithm},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return errSSHHostKeyCollected
+		},
+	}
+
+	_, _, _, err = ssh.NewClientConn(conn, address, config)
+	if hostKey == nil {
+		if err == nil {
+			err = fmt.Errorf("no %s host key", algorithm)
+		}
+		return nil, err
+	}
+	return hostKey, nil
+}
+
+func flattenSSHHostKey(key ssh.PublicKey) map[string]interface{} {
+	return map[string]interface{}{
+		"algorithm":          key.Type(),
+		"fingerprint_sha256": ssh.FingerprintSHA256(key),
+		"public_key":         strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+	}
+}
+
+// setServerSSHHostKeys collects the host keys of the server when `collect_ssh_host_keys` is set, and clears them otherwise.
+func setServerSSHHostKeys(d *schema.ResourceData, client *NcloudAPIClient) error {
+	if !d.Get("collect_ssh_host_keys").(bool) {
+		return d.Set("ssh_host_keys", nil)
+	}
+
+	keys, err := collectServerSSHHostKeys(client, d.Id(), 10*time.Minute)
+	if err != nil {
+		return fmt.Errorf("error collecting the SSH host keys of server instance [%s]: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Collected %d SSH host keys of server instance [%s]", len(keys), d.Id())
+	return d.Set("ssh_host_keys", keys)
+}
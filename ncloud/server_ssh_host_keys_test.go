@@ -0,0 +1,56 @@
+package ncloud
+
+import (
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestCollectSSHHostKeys(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				ssh.NewServerConn(conn, config)
+				conn.Close()
+			}()
+		}
+	}()
+
+	keys, err := collectSSHHostKeys(listener.Addr().String(), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 host key, but got %d", len(keys))
+	}
+	if keys[0]["algorithm"] != ssh.KeyAlgoED25519 {
+		t.Fatalf("expected algorithm %s, but was %s", ssh.KeyAlgoED25519, keys[0]["algorithm"])
+	}
+	if keys[0]["fingerprint_sha256"] != ssh.FingerprintSHA256(signer.PublicKey()) {
+		t.Fatalf("expected fingerprint %s, but was %s", ssh.FingerprintSHA256(signer.PublicKey()), keys[0]["fingerprint_sha256"])
+	}
+}
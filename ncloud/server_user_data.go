@@ -0,0 +1,99 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// isWindowsServerImageProductCode reports whether the server image product code is a Windows image, e.g. SPSW0WINNTEN0016A.
+func isWindowsServerImageProductCode(code string) bool {
+	return strings.Contains(code, "WIN")
+}
+
+// isGpuServerProductCode reports whether the server product code is a GPU product, e.g. SPSVRGPUSSD00001.
+func isGpuServerProductCode(code string) bool {
+	return strings.HasPrefix(code, "SPSVRGPU")
+}
+
+// gpuDriverInstallScript installs the NVIDIA driver from the distribution packages: ubuntu-drivers on Ubuntu,
+// and the ELRepo kmod-nvidia package on CentOS.
+const gpuDriverInstallScript = "if command -v ubuntu-drivers >/dev/null 2>&1; then apt-get update && ubuntu-drivers autoinstall; " +
+	"elif command -v yum >/dev/null 2>&1; then yum install -y elrepo-release && yum install -y kmod-nvidia; fi\n"
+
+// buildServerUserData returns the user data to create the server with: the `user_data` script with the commands setting
+// the `hostname` and `timezone` of the server, and installing the GPU driver, added to it.
+func buildServerUserData(client *NcloudAPIClient, d *schema.ResourceData, memberServerImageNo string) (string, error) {
+	userData := d.Get("user_data").(string)
+	hostname := d.Get("hostname").(string)
+	timezone := d.Get("timezone").(string)
+	installGpuDriver := d.Get("install_gpu_driver").(bool)
+	if hostname == "" && timezone == "" && !installGpuDriver {
+		return userData, nil
+	}
+
+	imageCode := d.Get("server_image_product_code").(string)
+	if memberServerImageNo != "" {
+		image, err := getMemberServerImage(client, memberServerImageNo)
+		if err != nil {
+			return "", err
+		}
+		if image == nil {
+			return "", fmt.Errorf("member server image [%s] not found", memberServerImageNo)
+		}
+		imageCode = ncloud.StringValue(image.OriginalServerImageProductCode)
+	}
+
+	return userDataWithHostSettings(userData, hostname, timezone, installGpuDriver, isWindowsServerImageProductCode(imageCode))
+}
+
+// userDataWithHostSettings adds the commands setting the hostname and the timezone, and installing the GPU driver,
+// to a user data script. On Linux the script must be a shell script, and the commands run first. On Windows the script
+// is run by PowerShell; the timezone is set first and the server is renamed last, restarting it for the new name to take effect.
+func userDataWithHostSettings(userData, hostname, timezone string, installGpuDriver bool, windows bool) (string, error) {
+	if windows {
+		if installGpuDriver {
+			return "", fmt.Errorf("install_gpu_driver is only supported on Linux server images")
+		}
+		var b strings.Builder
+		if timezone != "" {
+			fmt.Fprintf(&b, "tzutil /s \"%s\"\n", timezone)
+		}
+		b.WriteString(userData)
+		if hostname != "" {
+			if userData != "" && !strings.HasSuffix(userData, "\n") {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "Rename-Computer -NewName \"%s\" -Force -Restart\n", hostname)
+		}
+		return b.String(), nil
+	}
+
+	var prelude strings.Builder
+	if hostname != "" {
+		fmt.Fprintf(&prelude, "if command -v hostnamectl >/dev/null 2>&1; then hostnamectl set-hostname '%[1]s'; "+
+			"else hostname '%[1]s'; sed -i 's/^HOSTNAME=.*/HOSTNAME=%[1]s/' /etc/sysconfig/network; fi\n", hostname)
+	}
+	if timezone != "" {
+		fmt.Fprintf(&prelude, "if command -v timedatectl >/dev/null 2>&1; then timedatectl set-timezone '%[1]s'; "+
+			"else ln -sf '/usr/share/zoneinfo/%[1]s' /etc/localtime; fi\n", timezone)
+	}
+	if installGpuDriver {
+		prelude.WriteString(gpuDriverInstallScript)
+	}
+
+	if userData == "" {
+		return "#!/bin/sh\n" + prelude.String(), nil
+	}
+	if !strings.HasPrefix(userData, "#!") {
+		return "", fmt.Errorf("hostname, timezone and install_gpu_driver need user_data to be a shell script starting with #!")
+	}
+	shebang := userData
+	rest := ""
+	if i := strings.Index(userData, "\n"); i >= 0 {
+		shebang, rest = userData[:i], userData[i+1:]
+	}
+	return shebang + "\n" + prelude.String() + rest, nil
+}
@@ -0,0 +1,93 @@
+package ncloud
+
+import (
+	"testing"
+)
+
+func TestUserDataWithHostSettings(t *testing.T) {
+	r, err := userDataWithHostSettings("", "web-01", "Asia/Seoul", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "#!/bin/sh\n" +
+		"if command -v hostnamectl >/dev/null 2>&1; then hostnamectl set-hostname 'web-01'; else hostname 'web-01'; sed -i 's/^HOSTNAME=.*/HOSTNAME=web-01/' /etc/sysconfig/network; fi\n" +
+		"if command -v timedatectl >/dev/null 2>&1; then timedatectl set-timezone 'Asia/Seoul'; else ln -sf '/usr/share/zoneinfo/Asia/Seoul' /etc/localtime; fi\n"
+	if r != expected {
+		t.Fatalf("expected %q, but was %q", expected, r)
+	}
+}
+
+func TestUserDataWithHostSettings_shellScript(t *testing.T) {
+	r, err := userDataWithHostSettings("#!/bin/bash\nyum install -y httpd\n", "", "UTC", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "#!/bin/bash\n" +
+		"if command -v timedatectl >/dev/null 2>&1; then timedatectl set-timezone 'UTC'; else ln -sf '/usr/share/zoneinfo/UTC' /etc/localtime; fi\n" +
+		"yum install -y httpd\n"
+	if r != expected {
+		t.Fatalf("expected %q, but was %q", expected, r)
+	}
+}
+
+func TestUserDataWithHostSettings_notShellScript(t *testing.T) {
+	if _, err := userDataWithHostSettings("#cloud-config\npackages: [httpd]\n", "web-01", "", false, false); err == nil {
+		t.Fatalf("expected an error for user data that is not a shell script")
+	}
+}
+
+func TestUserDataWithHostSettings_windows(t *testing.T) {
+	r, err := userDataWithHostSettings("Install-WindowsFeature Web-Server", "web-01", "Korea Standard Time", false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "tzutil /s \"Korea Standard Time\"\n" +
+		"Install-WindowsFeature Web-Server\n" +
+		"Rename-Computer -NewName \"web-01\" -Force -Restart\n"
+	if r != expected {
+		t.Fatalf("expected %q, but was %q", expected, r)
+	}
+}
+
+func TestUserDataWithHostSettings_gpuDriver(t *testing.T) {
+	r, err := userDataWithHostSettings("#!/bin/bash\nnvidia-smi\n", "", "", true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "#!/bin/bash\n" + gpuDriverInstallScript + "nvidia-smi\n"
+	if r != expected {
+		t.Fatalf("expected %q, but was %q", expected, r)
+	}
+
+	if _, err := userDataWithHostSettings("", "", "", true, true); err == nil {
+		t.Fatalf("expected an error for a GPU driver on Windows")
+	}
+}
+
+func TestIsGpuServerProductCode(t *testing.T) {
+	cases := map[string]bool{
+		"SPSVRGPUSSD00001": true,
+		"SPSVRSTAND000004": false,
+		"":                 false,
+	}
+
+	for code, expected := range cases {
+		if isGpuServerProductCode(code) != expected {
+			t.Fatalf("expected isGpuServerProductCode(%q) to be %t", code, expected)
+		}
+	}
+}
+
+func TestIsWindowsServerImageProductCode(t *testing.T) {
+	cases := map[string]bool{
+		"SPSW0WINNTEN0016A": true,
+		"SPSW0LINUX000032":  false,
+		"":                  false,
+	}
+
+	for code, expected := range cases {
+		if isWindowsServerImageProductCode(code) != expected {
+			t.Fatalf("expected isWindowsServerImageProductCode(%q) to be %t", code, expected)
+		}
+	}
+}
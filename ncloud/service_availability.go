@@ -0,0 +1,107 @@
+package ncloud
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Services whose availability depends on the region
+const (
+	ServiceLoadBalancer = "Load Balancer"
+	ServiceNasVolume    = "NAS"
+)
+
+// serviceProbes list the instances of each service in a region. The request fails when the region does not provide the service.
+var serviceProbes = map[string]func(client *NcloudAPIClient, regionNo *string) error{
+	ServiceLoadBalancer: func(client *NcloudAPIClient, regionNo *string) error {
+		reqParams := &loadbalancer.GetLoadBalancerInstanceListRequest{RegionNo: regionNo, PageNo: ncloud.Int32(1), PageSize: ncloud.Int32(1)}
+		logCommonRequest("GetLoadBalancerInstanceList", reqParams)
+		resp, err := client.loadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
+			return err
+		}
+		logCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(resp))
+		return nil
+	},
+	ServiceNasVolume: func(client *NcloudAPIClient, regionNo *string) error {
+		reqParams := &server.GetNasVolumeInstanceListRequest{RegionNo: regionNo}
+		logCommonRequest("GetNasVolumeInstanceList", reqParams)
+		resp, err := client.server.V2Api.GetNasVolumeInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetNasVolumeInstanceList", err, reqParams)
+			return err
+		}
+		logCommonResponse("GetNasVolumeInstanceList", GetCommonResponse(resp))
+		return nil
+	},
+}
+
+// serviceAvailabilityCache keeps the result of the probe of a service by region, so a plan probes each region once.
+var serviceAvailabilityCache = struct {
+	sync.Mutex
+	errs map[string]error
+}{errs: make(map[string]error)}
+
+// checkServiceAvailable returns an error naming the service and the region when the region does not provide the service.
+// Only a request rejected by the API (400 or 404) means the service is unavailable; other probe errors are logged and left
+// to the requests of the apply.
+func checkServiceAvailable(client *NcloudAPIClient, service string, regionNo *string, region string) error {
+	key := service + "/" + ncloud.StringValue(regionNo)
+
+	serviceAvailabilityCache.Lock()
+	defer serviceAvailabilityCache.Unlock()
+
+	if err, ok := serviceAvailabilityCache.errs[key]; ok {
+		return err
+	}
+
+	var result error
+	if err := serviceProbes[service](client, regionNo); err != nil {
+		if !isServiceUnavailableErr(err) {
+			log.Printf("[WARN] Unable to check if %s is available in %s: %s", service, region, err)
+			return nil
+		}
+		result = fmt.Errorf("%s is not available in %s. use another region or remove the resource: %s", service, region, err)
+	}
+	serviceAvailabilityCache.errs[key] = result
+	return result
+}
+
+func isServiceUnavailableErr(err error) bool {
+	return strings.HasPrefix(err.Error(), "Status: 400") || strings.HasPrefix(err.Error(), "Status: 404")
+}
+
+// checkServiceAvailableForDiff checks the service in the region of a resource to create.
+// The region is not checked while it is only known after apply.
+func checkServiceAvailableForDiff(client *NcloudAPIClient, service string, diff *schema.ResourceDiff) error {
+	if diff.Id() != "" || !diff.NewValueKnown("region_no") || !diff.NewValueKnown("region_code") {
+		return nil
+	}
+
+	if regionNo, ok := knownString(diff, "region_no"); ok {
+		return checkServiceAvailable(client, service, ncloud.String(regionNo), fmt.Sprintf("region_no %s", regionNo))
+	}
+
+	regionCode, ok := knownString(diff, "region_code")
+	if !ok {
+		regionCode = os.Getenv("NCLOUD_REGION")
+	}
+	if regionCode == "" {
+		return checkServiceAvailable(client, service, nil, "the default region")
+	}
+
+	regionNo := getRegionNoByCode(client, regionCode)
+	if regionNo == nil {
+		return fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
+	}
+	return checkServiceAvailable(client, service, regionNo, fmt.Sprintf("region %s", regionCode))
+}
@@ -0,0 +1,44 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+func TestCheckServiceAvailable(t *testing.T) {
+	probes := 0
+	serviceProbes["test"] = func(client *NcloudAPIClient, regionNo *string) error {
+		probes++
+		switch ncloud.StringValue(regionNo) {
+		case "1":
+			return nil
+		case "2":
+			return fmt.Errorf("Status: 400 Bad Request, Body: {}")
+		default:
+			return fmt.Errorf("Status: 503 Service Unavailable, Body: {}")
+		}
+	}
+	defer delete(serviceProbes, "test")
+
+	if err := checkServiceAvailable(nil, "test", ncloud.String("1"), "region KR"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := checkServiceAvailable(nil, "test", ncloud.String("2"), "region JPN")
+	if err == nil || !strings.HasPrefix(err.Error(), "test is not available in region JPN") {
+		t.Fatalf("expected an unavailable service error, but was %v", err)
+	}
+
+	if err := checkServiceAvailable(nil, "test", ncloud.String("3"), "region USWN"); err != nil {
+		t.Fatalf("expected a server error of the probe to be ignored, but was %s", err)
+	}
+
+	checkServiceAvailable(nil, "test", ncloud.String("1"), "region KR")
+	checkServiceAvailable(nil, "test", ncloud.String("2"), "region JPN")
+	if probes != 3 {
+		t.Fatalf("expected 3 probes, but was %d", probes)
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"time"
 )
 
-var defaultDateFormat = "2006-01-02T15:04:00+0900"
+var defaultDateFormat = "2006-01-02T15:04:05-0700"
 
 type memberServerImageSort []*server.MemberServerImage
 
@@ -30,6 +30,15 @@ func mostRecentMemberServerImage(images []*server.MemberServerImage) *server.Mem
 	return sortedImages[len(sortedImages)-1]
 }
 
+// sortMemberServerImagesByCreateDate sorts the images by create date, oldest first for "asc" and newest first for "desc".
+func sortMemberServerImagesByCreateDate(images []*server.MemberServerImage, order string) {
+	if order == "desc" {
+		sort.Stable(sort.Reverse(memberServerImageSort(images)))
+	} else {
+		sort.Stable(memberServerImageSort(images))
+	}
+}
+
 type acgSort []*server.AccessControlGroup
 
 func (a acgSort) Len() int {
@@ -69,3 +78,32 @@ func mostRecentPublicIp(publicIPs []*server.PublicIpInstance) *server.PublicIpIn
 	sort.Sort(publicIPSort(sortedPublicIps))
 	return sortedPublicIps[len(sortedPublicIps)-1]
 }
+
+type blockStorageSnapshotSort []*server.BlockStorageSnapshotInstance
+
+func (a blockStorageSnapshotSort) Len() int {
+	return len(a)
+}
+func (a blockStorageSnapshotSort) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+func (a blockStorageSnapshotSort) Less(i, j int) bool {
+	iTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[i].CreateDate))
+	jTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[j].CreateDate))
+	return iTime.Unix() < jTime.Unix()
+}
+
+func mostRecentBlockStorageSnapshot(snapshots []*server.BlockStorageSnapshotInstance) *server.BlockStorageSnapshotInstance {
+	sortedSnapshots := snapshots
+	sort.Sort(blockStorageSnapshotSort(sortedSnapshots))
+	return sortedSnapshots[len(sortedSnapshots)-1]
+}
+
+// sortBlockStorageSnapshotsByCreateDate sorts the snapshots by create date, oldest first for "asc" and newest first for "desc".
+func sortBlockStorageSnapshotsByCreateDate(snapshots []*server.BlockStorageSnapshotInstance, order string) {
+	if order == "desc" {
+		sort.Stable(sort.Reverse(blockStorageSnapshotSort(snapshots)))
+	} else {
+		sort.Stable(blockStorageSnapshotSort(snapshots))
+	}
+}
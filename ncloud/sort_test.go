@@ -19,6 +19,24 @@ func TestMostRecentMemberServerImage(t *testing.T) {
 	}
 }
 
+func TestSortMemberServerImagesByCreateDate(t *testing.T) {
+	images := []*server.MemberServerImage{
+		{MemberServerImageNo: ncloud.String("1755"), CreateDate: ncloud.String("2014-02-06T15:21:41+0900")},
+		{MemberServerImageNo: ncloud.String("1756"), CreateDate: ncloud.String("2018-06-22T15:21:00+0900")},
+		{MemberServerImageNo: ncloud.String("1753"), CreateDate: ncloud.String("2012-06-22T15:21:00+0900")},
+	}
+
+	sortMemberServerImagesByCreateDate(images, "desc")
+	if *images[0].MemberServerImageNo != "1756" || *images[2].MemberServerImageNo != "1753" {
+		t.Fatalf("Expected: 1756, 1755, 1753, Actual: %s, %s, %s", *images[0].MemberServerImageNo, *images[1].MemberServerImageNo, *images[2].MemberServerImageNo)
+	}
+
+	sortMemberServerImagesByCreateDate(images, "asc")
+	if *images[0].MemberServerImageNo != "1753" || *images[2].MemberServerImageNo != "1756" {
+		t.Fatalf("Expected: 1753, 1755, 1756, Actual: %s, %s, %s", *images[0].MemberServerImageNo, *images[1].MemberServerImageNo, *images[2].MemberServerImageNo)
+	}
+}
+
 func TestMostRecentAccessControlGroup(t *testing.T) {
 	recentDate := "2018-06-22T15:21:00+0900"
 	images := []*server.AccessControlGroup{
@@ -44,3 +62,16 @@ func TestMostRecentPublicIp(t *testing.T) {
 		t.Fatalf("Expected: %s, Actual: %s", recentDate, *mostRecent.CreateDate)
 	}
 }
+
+func TestMostRecentBlockStorageSnapshot(t *testing.T) {
+	recentDate := "2018-07-02T03:00:00+0900"
+	snapshots := []*server.BlockStorageSnapshotInstance{
+		{BlockStorageSnapshotInstanceNo: ncloud.String("1"), CreateDate: ncloud.String("2018-07-01T03:00:00+0900")},
+		{BlockStorageSnapshotInstanceNo: ncloud.String("2"), CreateDate: ncloud.String(recentDate)},
+		{BlockStorageSnapshotInstanceNo: ncloud.String("3"), CreateDate: ncloud.String("2018-06-30T03:00:00+0900")},
+	}
+
+	if mostRecent := mostRecentBlockStorageSnapshot(snapshots); recentDate != *mostRecent.CreateDate {
+		t.Fatalf("Expected: %s, Actual: %s", recentDate, *mostRecent.CreateDate)
+	}
+}
@@ -0,0 +1,117 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// TestAccStackWebServers creates the stack users deploy most: servers in the default ACG behind a load balancer,
+// each with a data block storage, and a public IP associated with the first one.
+// The second step removes a server still bound to the load balancer and attached to a block storage, to check the destroy ordering.
+func TestAccStackWebServers(t *testing.T) {
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackWebServersConfig(testServerName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ncloud_load_balancer.lb", "server_instance_no_list.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_server.web.0", "access_control_group_configuration_no_list.0",
+						"data.ncloud_access_control_group.default", "access_control_group_configuration_no"),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_block_storage.data.1", "server_instance_no",
+						"ncloud_server.web.1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_public_ip_association.web", "server_instance_no",
+						"ncloud_server.web.0", "id"),
+				),
+			},
+			{
+				Config: testAccStackWebServersConfig(testServerName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ncloud_load_balancer.lb", "server_instance_no_list.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ncloud_load_balancer.lb", "server_instance_no_list.0",
+						"ncloud_server.web.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStackDestroy(s *terraform.State) error {
+	for _, check := range []resource.TestCheckFunc{
+		testAccCheckLoadBalancerDestroy,
+		testAccCheckPublicIpInstanceDestroy,
+		testAccCheckBlockStorageDestroy,
+		testAccCheckServerDestroy,
+		testAccCheckLoginKeyDestroy,
+	} {
+		if err := check(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccStackWebServersConfig(testServerName string, count int) string {
+	return fmt.Sprintf(`
+data "ncloud_access_control_group" "default" {
+	"is_default_group" = "true"
+}
+
+resource "ncloud_login_key" "loginkey" {
+	"key_name" = "%[1]s-key"
+}
+
+resource "ncloud_server" "web" {
+	"count" = %[2]d
+	"server_name" = "%[1]s-${count.index}"
+	"server_image_product_code" = "SPSW0LINUX000032"
+	"server_product_code" = "SPSVRSTAND000004"
+	"login_key_name" = "${ncloud_login_key.loginkey.key_name}"
+	"access_control_group_configuration_no_list" = ["${data.ncloud_access_control_group.default.access_control_group_configuration_no}"]
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_block_storage" "data" {
+	"count" = %[2]d
+	"server_instance_no" = "${element(ncloud_server.web.*.id, count.index)}"
+	"block_storage_size_gb" = "10"
+}
+
+resource "ncloud_public_ip" "web" {
+	"zone_code" = "KR-2"
+}
+
+resource "ncloud_public_ip_association" "web" {
+	"public_ip_instance_no" = "${ncloud_public_ip.web.id}"
+	"server_instance_no" = "${ncloud_server.web.0.id}"
+}
+
+resource "ncloud_load_balancer" "lb" {
+	"load_balancer_name" = "%[1]s-lb"
+	"load_balancer_algorithm_type_code" = "RR"
+	"load_balancer_rule_list" = [
+		{
+			"protocol_type_code" = "HTTP"
+			"load_balancer_port" = 80
+			"server_port" = 80
+			"l7_health_check_path" = "/"
+		},
+	]
+	"server_instance_no_list" = ["${ncloud_server.web.*.id}"]
+	"internet_line_type_code" = "PUBLC"
+	"network_usage_type_code" = "PBLIP"
+	"region_no" = "1"
+}
+`, testServerName, count)
+}
@@ -1,7 +1,6 @@
 package ncloud
 
 import (
-	"reflect"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -9,47 +8,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 )
 
-func TestExpandStringInterfaceList(t *testing.T) {
-	initialList := []string{"1111", "2222", "3333"}
-	l := make([]interface{}, len(initialList))
-	for i, v := range initialList {
-		l[i] = v
-	}
-	stringList := expandStringInterfaceList(l)
-	expected := []*string{
-		ncloud.String("1111"),
-		ncloud.String("2222"),
-		ncloud.String("3333"),
-	}
-	if !reflect.DeepEqual(stringList, expected) {
-		t.Fatalf(
-			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
-			stringList,
-			expected)
-	}
-}
-
-func TestFlattenCommonCode(t *testing.T) {
-	expanded := &server.CommonCode{
-		Code:     ncloud.String("code"),
-		CodeName: ncloud.String("codename"),
-	}
-
-	result := flattenCommonCode(expanded)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
-
-	if result["code"] != "code" {
-		t.Fatalf("expected result code to be code, but was %s", result["code"])
-	}
-
-	if result["code_name"] != "codename" {
-		t.Fatalf("expected result code_name to be codename, but was %s", result["code_name"])
-	}
-}
-
 func TestFlattenAccessControlRules(t *testing.T) {
 	expected := []*server.AccessControlRule{
 		{
@@ -150,173 +108,6 @@ func TestFlattenAccessControlGroups(t *testing.T) {
 	}
 }
 
-func TestFlattenRegion(t *testing.T) {
-	expanded := &server.Region{
-		RegionNo:   ncloud.String("1"),
-		RegionCode: ncloud.String("KR"),
-		RegionName: ncloud.String("Korea"),
-	}
-
-	result := flattenRegion(expanded)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
-
-	if result["region_no"] != "1" {
-		t.Fatalf("expected result region_no to be 1, but was %s", result["region_no"])
-	}
-
-	if result["region_code"] != "KR" {
-		t.Fatalf("expected result region_code to be KR, but was %s", result["region_code"])
-	}
-
-	if result["region_name"] != "Korea" {
-		t.Fatalf("expected result region_name to be Korea, but was %s", result["region_name"])
-	}
-}
-
-func TestFlattenZone(t *testing.T) {
-	expanded := &server.Zone{
-		ZoneNo:          ncloud.String("3"),
-		ZoneName:        ncloud.String("KR-2"),
-		ZoneCode:        ncloud.String("KR-2"),
-		ZoneDescription: ncloud.String("평촌 zone"),
-		RegionNo:        ncloud.String("1"),
-	}
-
-	result := flattenZone(expanded)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
-
-	if result["zone_no"] != "3" {
-		t.Fatalf("expected result zone_no to be 3, but was %s", result["zone_no"])
-	}
-
-	if result["zone_code"] != "KR-2" {
-		t.Fatalf("expected result zone_code to be KR-2, but was %s", result["zone_code"])
-	}
-
-	if result["zone_name"] != "KR-2" {
-		t.Fatalf("expected result zone_name to be KR-2, but was %s", result["zone_name"])
-	}
-
-	if result["zone_description"] != "평촌 zone" {
-		t.Fatalf("expected result zone_description to be 평촌 zone, but was %s", result["zone_description"])
-	}
-
-	if result["region_no"] != "1" {
-		t.Fatalf("expected result region_no to be 1, but was %s", result["region_no"])
-	}
-}
-
-func TestFlattenMemberServerImages(t *testing.T) {
-	expanded := []*server.MemberServerImage{
-		{
-			MemberServerImageNo:          ncloud.String("4653"),
-			MemberServerImageName:        ncloud.String("test-1514385790"),
-			MemberServerImageDescription: ncloud.String("server description"),
-			OriginalServerInstanceNo:     ncloud.String("572053"),
-			OriginalServerProductCode:    ncloud.String("SPSVRSTAND000004"),
-			OriginalServerName:           ncloud.String("svr-9bbaf27a2902b5c"),
-			OriginalBaseBlockStorageDiskType: &server.CommonCode{
-				Code:     ncloud.String("NET"),
-				CodeName: ncloud.String("Network Storage"),
-			},
-			OriginalServerImageProductCode: ncloud.String("SPSW0LINUX000043"),
-			OriginalOsInformation:          ncloud.String("CentOS 5.11 (64-bit)"),
-			OriginalServerImageName:        ncloud.String("centos-5.11-64"),
-			MemberServerImageStatusName:    ncloud.String("creating"),
-			MemberServerImageStatus: &server.CommonCode{
-				Code:     ncloud.String("INIT"),
-				CodeName: ncloud.String("NSI INIT state"),
-			},
-			MemberServerImageOperation: &server.CommonCode{
-				Code:     ncloud.String("CREAT"),
-				CodeName: ncloud.String("NSI CREAT OP"),
-			},
-			MemberServerImagePlatformType: &server.CommonCode{
-				Code:     ncloud.String("LNX64"),
-				CodeName: ncloud.String("Linux 64 Bit"),
-			},
-			CreateDate: ncloud.String("2018-01-07T10:17:14+0900"),
-			Region: &server.Region{
-				RegionNo:   ncloud.String("1"),
-				RegionCode: ncloud.String("KR"),
-				RegionName: ncloud.String("Korea"),
-			},
-			MemberServerImageBlockStorageTotalRows: ncloud.Int32(2),
-			MemberServerImageBlockStorageTotalSize: ncloud.Int64(1127428915200),
-		},
-	}
-
-	result := flattenMemberServerImages(expanded)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
-
-	if len(result) != 1 {
-		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
-	}
-
-	r := result[0]
-
-	if r["member_server_image_no"] != "4653" {
-		t.Fatalf("expected result member_server_image_no to be 4653, but was %s", r["member_server_image_no"])
-	}
-
-	if r["member_server_image_name"] != "test-1514385790" {
-		t.Fatalf("expected result member_server_image_name to be test-1514385790, but was %s", r["member_server_image_name"])
-	}
-
-	if r["member_server_image_description"] != "server description" {
-		t.Fatalf("expected result member_server_image_description to be 'server description', but was %s", r["member_server_image_description"])
-	}
-
-	if r["original_server_instance_no"] != "572053" {
-		t.Fatalf("expected result original_server_instance_no to be 572053, but was %s", r["original_server_instance_no"])
-	}
-
-	if r["original_server_product_code"] != "SPSVRSTAND000004" {
-		t.Fatalf("expected result original_server_product_code to be SPSVRSTAND000004, but was %s", r["original_server_product_code"])
-	}
-
-	if r["original_server_name"] != "svr-9bbaf27a2902b5c" {
-		t.Fatalf("expected result original_server_name to be svr-9bbaf27a2902b5c, but was %s", r["original_server_name"])
-	}
-
-	if r["original_server_image_product_code"] != "SPSW0LINUX000043" {
-		t.Fatalf("expected result original_server_image_product_code to be SPSW0LINUX000043, but was %s", r["original_server_image_product_code"])
-	}
-
-	if r["original_os_information"] != "CentOS 5.11 (64-bit)" {
-		t.Fatalf("expected result original_os_information to be CentOS 5.11 (64-bit), but was %s", r["original_os_information"])
-	}
-
-	if r["original_server_image_name"] != "centos-5.11-64" {
-		t.Fatalf("expected result original_server_image_name to be centos-5.11-64, but was %s", r["original_server_image_name"])
-	}
-
-	if r["member_server_image_status_name"] != "creating" {
-		t.Fatalf("expected result member_server_image_status_name to be creating, but was %s", r["member_server_image_status_name"])
-	}
-
-	if r["create_date"] != "2018-01-07T10:17:14+0900" {
-		t.Fatalf("expected result create_date to be 2018-01-07T10:17:14+0900, but was %s", r["create_date"])
-	}
-
-	if r["member_server_image_block_storage_total_rows"] != 2 {
-		t.Fatalf("expected result member_server_image_block_storage_total_rows to be 2, but was %s", r["member_server_image_block_storage_total_rows"])
-	}
-
-	if r["member_server_image_block_storage_total_size"] != 1127428915200 {
-		t.Fatalf("expected result member_server_image_block_storage_total_size to be 1127428915200, but was %s", r["member_server_image_block_storage_total_size"])
-	}
-}
-
 func TestFlattenCustomIPList(t *testing.T) {
 	expanded := []*server.NasVolumeInstanceCustomIp{
 		{
@@ -518,76 +309,6 @@ func TestFlattenNasVolumeInstances(t *testing.T) {
 	}
 }
 
-func TestExpandLoadBalancerRuleParams(t *testing.T) {
-	lbrulelist := []interface{}{
-		map[string]interface{}{
-			"protocol_type_code":   "HTTP",
-			"load_balancer_port":   80,
-			"server_port":          80,
-			"l7_health_check_path": "/monitor/l7check",
-		},
-		map[string]interface{}{
-			"protocol_type_code":   "HTTPS",
-			"load_balancer_port":   443,
-			"server_port":          443,
-			"l7_health_check_path": "/monitor/l7check",
-			"certificate_name":     "aaa",
-		},
-	}
-
-	result, _ := expandLoadBalancerRuleParams(lbrulelist)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
-
-	if len(result) != 2 {
-		t.Fatalf("expected result had %d elements, but got %d", 2, len(result))
-	}
-
-	r := result[0]
-	if *r.ProtocolTypeCode != "HTTP" {
-		t.Fatalf("expected result ProtocolTypeCode to be HTTP, but was %s", *r.ProtocolTypeCode)
-	}
-
-	if *r.LoadBalancerPort != 80 {
-		t.Fatalf("expected result LoadBalancerPort to be 80, but was %d", *r.LoadBalancerPort)
-	}
-
-	if *r.ServerPort != 80 {
-		t.Fatalf("expected result ServerPort to be 80, but was %d", *r.ServerPort)
-	}
-
-	if *r.L7HealthCheckPath != "/monitor/l7check" {
-		t.Fatalf("expected result L7HealthCheckPath to be '/monitor/l7check', but was %s", *r.L7HealthCheckPath)
-	}
-
-	if r.CertificateName != nil {
-		t.Fatalf("expected result CertificateName to be nil, but was %s", *r.CertificateName)
-	}
-
-	r = result[1]
-	if *r.ProtocolTypeCode != "HTTPS" {
-		t.Fatalf("expected result ProtocolTypeCode to be HTTPS, but was %s", *r.ProtocolTypeCode)
-	}
-
-	if *r.LoadBalancerPort != 443 {
-		t.Fatalf("expected result LoadBalancerPort to be 443, but was %d", *r.LoadBalancerPort)
-	}
-
-	if *r.ServerPort != 443 {
-		t.Fatalf("expected result ServerPort to be 443, but was %d", *r.ServerPort)
-	}
-
-	if *r.L7HealthCheckPath != "/monitor/l7check" {
-		t.Fatalf("expected result L7HealthCheckPath to be '/monitor/l7check', but was %s", *r.L7HealthCheckPath)
-	}
-
-	if *r.CertificateName != "aaa" {
-		t.Fatalf("expected result CertificateName to be aaa, but was %s", *r.CertificateName)
-	}
-}
-
 func TestFlattenLoadBalancerRuleList(t *testing.T) {
 	expanded := []*loadbalancer.LoadBalancerRule{
 		{
@@ -670,19 +391,19 @@ func TestFlattenLoadBalancedServerInstanceList(t *testing.T) {
 	}
 }
 
-func TestExpandTagListParams(t *testing.T) {
-	lbrulelist := []interface{}{
-		map[string]interface{}{
-			"tag_key":   "dev",
-			"tag_value": "web",
+func TestFlattenInstanceTagList(t *testing.T) {
+	expanded := []*server.InstanceTag{
+		{
+			TagKey:   ncloud.String("dev"),
+			TagValue: ncloud.String("web"),
 		},
-		map[string]interface{}{
-			"tag_key":   "prod",
-			"tag_value": "auth",
+		{
+			TagKey:   ncloud.String("prod"),
+			TagValue: ncloud.String("auth"),
 		},
 	}
 
-	result, _ := expandTagListParams(lbrulelist)
+	result := flattenInstanceTagList(expanded)
 
 	if result == nil {
 		t.Fatal("result was nil")
@@ -692,62 +413,157 @@ func TestExpandTagListParams(t *testing.T) {
 		t.Fatalf("expected result had %d elements, but got %d", 2, len(result))
 	}
 
-	tag := result[0]
-	if *tag.TagKey != "dev" {
-		t.Fatalf("expected result ProtocolTypeCode to be dev, but was %s", *tag.TagKey)
+	r := result[0]
+	if r["tag_key"] != "dev" {
+		t.Fatalf("expected result tag_key to be 'dev', but was %s", r["tag_key"])
 	}
 
-	if *tag.TagValue != "web" {
-		t.Fatalf("expected result ProtocolTypeCode to be web, but was %s", *tag.TagValue)
+	if r["tag_value"] != "web" {
+		t.Fatalf("expected result tag_value to be 'web', but was %s", r["tag_value"])
 	}
 
-	tag = result[1]
-	if *tag.TagKey != "prod" {
-		t.Fatalf("expected result ProtocolTypeCode to be prod, but was %s", *tag.TagKey)
+	r = result[1]
+	if r["tag_key"] != "prod" {
+		t.Fatalf("expected result tag_key to be 'prod', but was %s", r["tag_key"])
 	}
 
-	if *tag.TagValue != "auth" {
-		t.Fatalf("expected result ProtocolTypeCode to be auth, but was %s", *tag.TagValue)
+	if r["tag_value"] != "auth" {
+		t.Fatalf("expected result tag_value to be 'auth', but was %s", r["tag_value"])
 	}
 }
 
-func TestFlattenInstanceTagList(t *testing.T) {
-	expanded := []*server.InstanceTag{
+func TestFlattenPublicIpInstances(t *testing.T) {
+	expanded := []*server.PublicIpInstance{
 		{
-			TagKey:   ncloud.String("dev"),
-			TagValue: ncloud.String("web"),
+			PublicIpInstanceNo:         ncloud.String("100"),
+			PublicIp:                   ncloud.String("10.0.0.1"),
+			PublicIpInstanceStatusName: ncloud.String("created"),
+			ServerInstanceAssociatedWithPublicIp: &server.ServerInstance{
+				ServerInstanceNo: ncloud.String("200"),
+				ServerName:       ncloud.String("web"),
+			},
 		},
 		{
-			TagKey:   ncloud.String("prod"),
-			TagValue: ncloud.String("auth"),
+			PublicIpInstanceNo: ncloud.String("101"),
+			PublicIp:           ncloud.String("10.0.0.2"),
 		},
 	}
 
-	result := flattenInstanceTagList(expanded)
-
-	if result == nil {
-		t.Fatal("result was nil")
-	}
+	result := flattenPublicIpInstances(expanded)
 
 	if len(result) != 2 {
 		t.Fatalf("expected result had %d elements, but got %d", 2, len(result))
 	}
 
 	r := result[0]
-	if r["tag_key"] != "dev" {
-		t.Fatalf("expected result tag_key to be 'dev', but was %s", r["tag_key"])
+	if r["public_ip"] != "10.0.0.1" {
+		t.Fatalf("expected result public_ip to be '10.0.0.1', but was %s", r["public_ip"])
 	}
-
-	if r["tag_value"] != "web" {
-		t.Fatalf("expected result tag_value to be 'web', but was %s", r["tag_value"])
+	if r["server_instance_no"] != "200" {
+		t.Fatalf("expected result server_instance_no to be '200', but was %s", r["server_instance_no"])
+	}
+	if r["server_name"] != "web" {
+		t.Fatalf("expected result server_name to be 'web', but was %s", r["server_name"])
 	}
 
 	r = result[1]
-	if r["tag_key"] != "prod" {
-		t.Fatalf("expected result tag_key to be 'prod', but was %s", r["tag_key"])
+	if _, ok := r["server_instance_no"]; ok {
+		t.Fatalf("expected no server_instance_no for an unassociated public ip, but was %s", r["server_instance_no"])
 	}
+}
 
-	if r["tag_value"] != "auth" {
-		t.Fatalf("expected result tag_value to be 'auth', but was %s", r["tag_value"])
+func TestFlattenBlockStorageInstances(t *testing.T) {
+	expanded := []*server.BlockStorageInstance{
+		{
+			BlockStorageInstanceNo: ncloud.String("300"),
+			BlockStorageName:       ncloud.String("data"),
+			ServerInstanceNo:       ncloud.String("200"),
+			DeviceName:             ncloud.String("/dev/xvdb"),
+			BlockStorageSize:       ncloud.Int64(10 * GiB),
+			DiskDetailType:         &server.CommonCode{Code: ncloud.String("SSD"), CodeName: ncloud.String("SSD")},
+		},
+	}
+
+	result := flattenBlockStorageInstances(expanded)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["block_storage_instance_no"] != "300" {
+		t.Fatalf("expected result block_storage_instance_no to be '300', but was %s", r["block_storage_instance_no"])
+	}
+	if r["device_name"] != "/dev/xvdb" {
+		t.Fatalf("expected result device_name to be '/dev/xvdb', but was %s", r["device_name"])
+	}
+	if r["block_storage_size_gb"] != 10 {
+		t.Fatalf("expected result block_storage_size_gb to be 10, but was %v", r["block_storage_size_gb"])
+	}
+	if code := r["disk_detail_type"].([]map[string]interface{})[0]["code"]; code != "SSD" {
+		t.Fatalf("expected result disk_detail_type code to be 'SSD', but was %v", code)
+	}
+}
+
+func TestFlattenPrivateSubnetInstances(t *testing.T) {
+	expanded := []*server.PrivateSubnetInstance{
+		{
+			PrivateSubnetInstanceNo: ncloud.String("300"),
+			Subnet:                  ncloud.String("10.0.0.0/24"),
+			Zone:                    &server.Zone{ZoneNo: ncloud.String("2")},
+		},
+	}
+
+	result := flattenPrivateSubnetInstances(expanded)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["private_subnet_instance_no"] != "300" {
+		t.Fatalf("expected result private_subnet_instance_no to be '300', but was %s", r["private_subnet_instance_no"])
+	}
+	if r["subnet"] != "10.0.0.0/24" {
+		t.Fatalf("expected result subnet to be '10.0.0.0/24', but was %s", r["subnet"])
+	}
+	if r["zone_no"] != "2" {
+		t.Fatalf("expected result zone_no to be '2', but was %s", r["zone_no"])
+	}
+}
+
+func TestFlattenServerInstance(t *testing.T) {
+	expanded := &server.ServerInstance{
+		ServerInstanceNo:  ncloud.String("100"),
+		ServerName:        ncloud.String("web"),
+		ServerDescription: ncloud.String("web server"),
+		CpuCount:          ncloud.Int32(2),
+		PrivateIp:         ncloud.String("10.0.0.1"),
+		Zone:              &server.Zone{ZoneNo: ncloud.String("2")},
+		InstanceTagList: []*server.InstanceTag{
+			{TagKey: ncloud.String("env"), TagValue: ncloud.String("prod")},
+			{TagKey: ncloud.String("metadata:team"), TagValue: ncloud.String("infra")},
+		},
+	}
+
+	r := flattenServerInstance(expanded)
+
+	if r["server_instance_no"] != "100" {
+		t.Fatalf("expected result server_instance_no to be '100', but was %s", r["server_instance_no"])
+	}
+	if r["server_description"] != "web server" {
+		t.Fatalf("expected result server_description to be 'web server', but was %s", r["server_description"])
+	}
+	if r["metadata"].(map[string]string)["team"] != "infra" {
+		t.Fatalf("expected result metadata team to be 'infra', but was %v", r["metadata"])
+	}
+	if r["cpu_count"] != 2 {
+		t.Fatalf("expected result cpu_count to be 2, but was %v", r["cpu_count"])
+	}
+	if r["zone"].(map[string]interface{})["zone_no"] != "2" {
+		t.Fatalf("expected result zone_no to be '2', but was %v", r["zone"])
+	}
+	if tags := r["tag_list"].([]map[string]interface{}); len(tags) != 1 || tags[0]["tag_value"] != "prod" {
+		t.Fatalf("expected result tag_list to have tag env=prod, but was %v", tags)
 	}
 }
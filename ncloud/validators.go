@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/crypto/ssh"
 	"strconv"
 )
 
@@ -92,6 +94,48 @@ func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// validateCreateDate checks a date in the format of the create dates returned by ncloud, e.g. 2018-07-12T20:32:45+0900.
+func validateCreateDate(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(defaultDateFormat, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be formatted like 2018-07-12T20:32:45+0900: %s", k, err))
+	}
+	return
+}
+
+var hostnamePattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
+// validateHostname checks a single hostname label: alphabets, numbers and hyphens, not starting or ending with a hyphen.
+func validateHostname(v interface{}, k string) (ws []string, errors []error) {
+	if !hostnamePattern.MatchString(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q must be 1 to 63 alphabets, numbers and hyphens (-), and cannot start or end with a hyphen", k))
+	}
+	return
+}
+
+var timezonePattern = regexp.MustCompile(`^[A-Za-z0-9_+/ -]+$`)
+
+// validateTimezone checks a time zone name such as Asia/Seoul or Korea Standard Time.
+func validateTimezone(v interface{}, k string) (ws []string, errors []error) {
+	if !timezonePattern.MatchString(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q must be a time zone name such as Asia/Seoul, or a Windows time zone ID such as Korea Standard Time", k))
+	}
+	return
+}
+
+func validateSSHPublicKey(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(v.(string))); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a public key in OpenSSH authorized_keys format: %s", k, err))
+	}
+	return
+}
+
 func validateIncludeValues(includeValues []string) schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
 
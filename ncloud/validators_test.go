@@ -1,6 +1,12 @@
 package ncloud
 
-import "testing"
+import (
+	"crypto/rand"
+	"testing"
+
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
 
 func TestValidateBoolValue(t *testing.T) {
 	if _, errs := validateBoolValue("true", "boolValue"); len(errs) > 0 {
@@ -107,6 +113,82 @@ func TestValidateRegexp_shouldReturnError(t *testing.T) {
 	}
 }
 
+func TestValidateDuration(t *testing.T) {
+	if _, errs := validateDuration("5m", "timeout"); len(errs) > 0 {
+		t.Fatalf("Error: %s", errs)
+	}
+}
+
+func TestValidateDuration_shouldReturnError(t *testing.T) {
+	if _, errs := validateDuration("5 minutes", "timeout"); len(errs) == 0 {
+		t.Fatalf("Expected: \"timeout\": time: unknown unit")
+	}
+}
+
+func TestValidateCreateDate(t *testing.T) {
+	if _, errs := validateCreateDate("2018-07-12T20:32:45+0900", "created_after"); len(errs) > 0 {
+		t.Fatalf("Error: %s", errs)
+	}
+}
+
+func TestValidateCreateDate_shouldReturnError(t *testing.T) {
+	for _, v := range []string{"2018-07-12", "2018-07-12T20:32:45+09:00"} {
+		if _, errs := validateCreateDate(v, "created_after"); len(errs) == 0 {
+			t.Fatalf("Expected %q to be an invalid create date", v)
+		}
+	}
+}
+
+func TestValidateHostname(t *testing.T) {
+	for _, v := range []string{"web-01", "a", "WEB1"} {
+		if _, errs := validateHostname(v, "hostname"); len(errs) > 0 {
+			t.Fatalf("Error: %s", errs)
+		}
+	}
+}
+
+func TestValidateHostname_shouldReturnError(t *testing.T) {
+	for _, v := range []string{"", "-web", "web-", "web.example.com", "web;reboot"} {
+		if _, errs := validateHostname(v, "hostname"); len(errs) == 0 {
+			t.Fatalf("Expected %q to be an invalid hostname", v)
+		}
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	for _, v := range []string{"Asia/Seoul", "Etc/GMT+9", "Korea Standard Time"} {
+		if _, errs := validateTimezone(v, "timezone"); len(errs) > 0 {
+			t.Fatalf("Error: %s", errs)
+		}
+	}
+}
+
+func TestValidateTimezone_shouldReturnError(t *testing.T) {
+	for _, v := range []string{"", "Asia/Seoul'; reboot", "$(id)"} {
+		if _, errs := validateTimezone(v, "timezone"); len(errs) == 0 {
+			t.Fatalf("Expected %q to be an invalid timezone", v)
+		}
+	}
+}
+
+func TestValidateSSHPublicKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, errs := validateSSHPublicKey(string(ssh.MarshalAuthorizedKey(key)), "public_key"); len(errs) > 0 {
+		t.Fatalf("Error: %s", errs)
+	}
+	if _, errs := validateSSHPublicKey("ssh-rsa not-a-key", "public_key"); len(errs) == 0 {
+		t.Fatalf("Expected an invalid public key")
+	}
+}
+
 func TestValidateIncludeValues(t *testing.T) {
 	f := validateIncludeValues([]string{"a", "b", "c"})
 	if _, errs := f("a", "test"); len(errs) > 0 {